@@ -0,0 +1,5 @@
+data "azdo_client_config" "current" {}
+
+output "running_as" {
+  value = data.azdo_client_config.current.account_name
+}
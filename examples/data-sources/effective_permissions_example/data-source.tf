@@ -0,0 +1,9 @@
+data "azdo_identity" "contributors" {
+  display_name = "[Project]\\Contributors"
+}
+
+data "azdo_effective_permissions" "example" {
+  namespace_name = "Git Repositories"
+  token          = provider::azdo::git_repository_token(data.azdo_identity.contributors.project_id, "")
+  principal      = data.azdo_identity.contributors.descriptor
+}
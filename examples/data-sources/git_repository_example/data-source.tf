@@ -0,0 +1,4 @@
+data "azdo_git_repository" "example" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+  name       = "pipeline-templates"
+}
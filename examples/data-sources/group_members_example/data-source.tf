@@ -0,0 +1,4 @@
+data "azdo_group_members" "example" {
+  group    = "[DefaultCollection]\\Production"
+  expanded = true
+}
@@ -0,0 +1,4 @@
+data "azdo_group_memberships_of" "example" {
+  identity = "Kubernetes Build Service (DefaultCollection)"
+  expanded = true
+}
@@ -1,3 +1,6 @@
 data "azdo_identity" "example" {
   display_name = "[DefaultCollection]\\Production"
-}
\ No newline at end of file
+}
+data "azdo_identity" "by_descriptor" {
+  descriptor = "Microsoft.TeamFoundation.Identity;S-1-9-1551374245-1204400969-2402986413-2179408616-0-0-0-0-1"
+}
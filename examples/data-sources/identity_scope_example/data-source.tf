@@ -0,0 +1,3 @@
+data "azdo_identity_scope" "example" {
+  name = "Templates"
+}
@@ -0,0 +1 @@
+data "azdo_identity_scopes" "example" {}
@@ -0,0 +1,3 @@
+data "azdo_policy_types" "example" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+}
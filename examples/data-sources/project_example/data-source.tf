@@ -0,0 +1,3 @@
+data "azdo_project" "example" {
+  name = "Templates"
+}
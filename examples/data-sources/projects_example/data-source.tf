@@ -0,0 +1,6 @@
+data "azdo_projects" "example" {
+}
+
+output "project_names" {
+  value = [for project in data.azdo_projects.example.projects : project.name]
+}
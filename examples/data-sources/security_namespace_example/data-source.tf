@@ -0,0 +1,3 @@
+data "azdo_security_namespace" "example" {
+  name = "Git Repositories"
+}
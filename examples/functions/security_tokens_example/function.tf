@@ -0,0 +1,35 @@
+terraform {
+  required_providers {
+    azdo = {
+      source = "JdnDevops/azdo"
+    }
+  }
+}
+
+locals {
+  project_id = "00000000-0000-0000-0000-000000000000"
+}
+
+output "project_token" {
+  value = provider::azdo::project_token(local.project_id)
+}
+
+output "git_repository_token" {
+  value = provider::azdo::git_repository_token(local.project_id, "11111111-1111-1111-1111-111111111111")
+}
+
+output "git_branch_token" {
+  value = provider::azdo::git_branch_token(local.project_id, "11111111-1111-1111-1111-111111111111", "main")
+}
+
+output "build_folder_token" {
+  value = provider::azdo::build_folder_token(local.project_id, "\\Team\\Production")
+}
+
+output "build_definition_token" {
+  value = provider::azdo::build_definition_token(local.project_id, "\\Team\\Production", "42")
+}
+
+output "classification_node_token" {
+  value = provider::azdo::classification_node_token(["22222222-2222-2222-2222-222222222222", "33333333-3333-3333-3333-333333333333"])
+}
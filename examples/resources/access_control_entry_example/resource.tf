@@ -0,0 +1,11 @@
+data "azdo_identity" "readers" {
+  display_name = "[Templates]\\Readers"
+}
+
+resource "azdo_access_control_entry" "example" {
+  namespace_name = "Library"
+  token          = "Library/00000000-0000-0000-0000-000000000000"
+  principal      = data.azdo_identity.readers.descriptor
+  allow          = ["View"]
+  deny           = ["Administer"]
+}
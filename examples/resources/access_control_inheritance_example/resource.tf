@@ -0,0 +1,14 @@
+data "azdo_identity" "administrators" {
+  display_name = "[Templates]\\Project Administrators"
+}
+
+resource "azdo_access_control_inheritance" "example" {
+  namespace_name = "Git Repositories"
+  token          = provider::azdo::git_repository_token(data.azdo_identity.administrators.project_id, "00000000-0000-0000-0000-000000000000")
+  inherit        = false
+
+  entries = [{
+    principal = data.azdo_identity.administrators.descriptor
+    allow     = ["GenericRead", "GenericContribute", "ManagePermissions"]
+  }]
+}
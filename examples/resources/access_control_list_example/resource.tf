@@ -0,0 +1,25 @@
+data "azdo_identity" "administrators" {
+  display_name = "[Templates]\\Project Administrators"
+}
+
+data "azdo_identity" "contributors" {
+  display_name = "[Templates]\\Contributors"
+}
+
+resource "azdo_access_control_list" "example" {
+  namespace_name = "Git Repositories"
+  token          = provider::azdo::git_repository_token(data.azdo_identity.administrators.project_id, "00000000-0000-0000-0000-000000000000")
+  inherit        = false
+
+  entries = [
+    {
+      principal = data.azdo_identity.administrators.descriptor
+      allow     = ["GenericRead", "GenericContribute", "ManagePermissions"]
+    },
+    {
+      principal = data.azdo_identity.contributors.descriptor
+      allow     = ["GenericRead"]
+      deny      = ["ForcePush"]
+    },
+  ]
+}
@@ -0,0 +1,9 @@
+data "azdo_identity" "build_administrators" {
+  display_name = "[Templates]\\Build Administrators"
+}
+
+resource "azdo_agent_pool_role_assignment" "example" {
+  pool_id   = 12
+  principal = data.azdo_identity.build_administrators.descriptor
+  role      = "Administrator"
+}
@@ -0,0 +1,10 @@
+data "azdo_identity" "contributors" {
+  display_name = "[Templates]\\Contributors"
+}
+
+resource "azdo_agent_queue_role_assignment" "example" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+  queue_id   = 34
+  principal  = data.azdo_identity.contributors.descriptor
+  role       = "User"
+}
@@ -0,0 +1,10 @@
+resource "azdo_area_path" "platform" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+  name       = "Platform"
+}
+
+resource "azdo_area_path" "pipelines" {
+  project_id  = "00000000-0000-0000-0000-000000000000"
+  name        = "Pipelines"
+  parent_path = azdo_area_path.platform.path
+}
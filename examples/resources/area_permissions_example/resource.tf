@@ -0,0 +1,14 @@
+data "azdo_identity" "contributors" {
+  display_name = "[Templates]\\Contributors"
+}
+
+resource "azdo_area_permissions" "example" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+  path       = "Templates\\Team\\Sub"
+  principal  = data.azdo_identity.contributors.descriptor
+  permissions = {
+    GENERIC_READ    = "Allow"
+    WORK_ITEM_WRITE = "Allow"
+    CREATE_CHILDREN = "Deny"
+  }
+}
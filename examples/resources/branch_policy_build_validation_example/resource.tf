@@ -0,0 +1,14 @@
+resource "azdo_branch_policy_build_validation" "example" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+
+  scope = [{
+    repository_id = "00000000-0000-0000-0000-000000000000"
+    ref_name      = "refs/heads/main"
+  }]
+
+  settings = {
+    build_definition_id = 42
+    display_name        = "CI"
+    filename_patterns   = ["/src/*", "!/docs/*"]
+  }
+}
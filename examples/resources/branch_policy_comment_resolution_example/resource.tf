@@ -0,0 +1,7 @@
+resource "azdo_branch_policy_comment_resolution" "example" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+
+  scope = [{
+    match_type = "DefaultBranch"
+  }]
+}
@@ -0,0 +1,13 @@
+resource "azdo_branch_policy_merge_types" "example" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+
+  scope = [{
+    repository_id = "00000000-0000-0000-0000-000000000000"
+    ref_name      = "refs/heads/main"
+  }]
+
+  settings = {
+    allow_squash       = true
+    allow_rebase_merge = true
+  }
+}
@@ -0,0 +1,13 @@
+resource "azdo_branch_policy_min_reviewers" "example" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+
+  scope = [{
+    repository_id = "00000000-0000-0000-0000-000000000000"
+    ref_name      = "refs/heads/main"
+  }]
+
+  settings = {
+    minimum_approver_count = 2
+    reset_on_source_push   = true
+  }
+}
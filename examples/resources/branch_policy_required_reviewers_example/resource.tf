@@ -0,0 +1,17 @@
+data "azdo_identity" "reviewers" {
+  display_name = "[Templates]\\Reviewers"
+}
+
+resource "azdo_branch_policy_required_reviewers" "example" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+
+  scope = [{
+    ref_name   = "refs/heads/release/"
+    match_type = "Prefix"
+  }]
+
+  settings = {
+    required_reviewer_ids = [data.azdo_identity.reviewers.descriptor]
+    message               = "Releases are reviewed by the release team"
+  }
+}
@@ -0,0 +1,9 @@
+resource "azdo_branch_policy_work_item_linking" "example" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+  blocking   = false
+
+  scope = [{
+    repository_id = "00000000-0000-0000-0000-000000000000"
+    ref_name      = "refs/heads/main"
+  }]
+}
@@ -0,0 +1,24 @@
+data "azdo_identity" "contributors" {
+  display_name = "[Templates]\\Contributors"
+}
+
+resource "azdo_build_permissions" "folder" {
+  project_id  = "00000000-0000-0000-0000-000000000000"
+  folder_path = "\\Team\\Production"
+  principal   = data.azdo_identity.contributors.descriptor
+  permissions = {
+    QueueBuilds                = "Allow"
+    EditBuildDefinition        = "Deny"
+    AdministerBuildPermissions = "Deny"
+  }
+}
+
+resource "azdo_build_permissions" "definition" {
+  project_id    = "00000000-0000-0000-0000-000000000000"
+  folder_path   = "\\Team\\Production"
+  definition_id = 42
+  principal     = data.azdo_identity.contributors.descriptor
+  permissions = {
+    EditBuildDefinition = "Allow"
+  }
+}
@@ -0,0 +1,14 @@
+data "azdo_identity" "release_managers" {
+  display_name = "[Templates]\\Release Managers"
+}
+
+resource "azdo_environment_check_approval" "example" {
+  project_id     = "00000000-0000-0000-0000-000000000000"
+  environment_id = 7
+  approvers = [
+    data.azdo_identity.release_managers.descriptor,
+    "[Templates]\\Project Administrators",
+  ]
+  minimum_approvers = 1
+  instructions      = "Check the release notes before approving"
+}
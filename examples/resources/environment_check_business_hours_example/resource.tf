@@ -0,0 +1,8 @@
+resource "azdo_environment_check_business_hours" "example" {
+  project_id     = "00000000-0000-0000-0000-000000000000"
+  environment_id = 7
+  time_zone      = "W. Europe Standard Time"
+  start_time     = "08:00"
+  end_time       = "17:30"
+  days           = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+}
@@ -0,0 +1,10 @@
+data "azdo_identity" "release_managers" {
+  display_name = "[Templates]\\Release Managers"
+}
+
+resource "azdo_environment_role_assignment" "example" {
+  project_id     = "00000000-0000-0000-0000-000000000000"
+  environment_id = 7
+  principal      = data.azdo_identity.release_managers.descriptor
+  role           = "Administrator"
+}
@@ -0,0 +1,15 @@
+data "azdo_identity" "contributors" {
+  display_name = "[Templates]\\Contributors"
+}
+
+resource "azdo_git_permissions" "example" {
+  project_id    = "00000000-0000-0000-0000-000000000000"
+  repository_id = "00000000-0000-0000-0000-000000000000"
+  branch_name   = "main"
+  principal     = data.azdo_identity.contributors.descriptor
+  permissions = {
+    GenericContribute = "Allow"
+    ForcePush         = "Deny"
+    ManagePermissions = "NotSet"
+  }
+}
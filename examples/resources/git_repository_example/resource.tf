@@ -0,0 +1,18 @@
+resource "azdo_git_repository" "example" {
+  project_id     = "00000000-0000-0000-0000-000000000000"
+  name           = "pipeline-templates"
+  default_branch = "refs/heads/main"
+  initialize     = true
+}
+
+resource "azdo_git_repository" "imported" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+  name       = "legacy-tools"
+  import_url = "https://git.example.com/legacy-tools.git"
+}
+
+resource "azdo_git_repository" "fork" {
+  project_id           = "00000000-0000-0000-0000-000000000000"
+  name                 = "pipeline-templates-fork"
+  parent_repository_id = azdo_git_repository.example.id
+}
@@ -0,0 +1,9 @@
+data "azdo_identity_scope" "project" {
+  name = "Templates"
+}
+
+resource "azdo_group" "example" {
+  scope_id     = data.azdo_identity_scope.project.id
+  display_name = "Release Managers"
+  description  = "Members can approve production releases"
+}
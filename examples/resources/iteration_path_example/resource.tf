@@ -0,0 +1,12 @@
+resource "azdo_iteration_path" "release" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+  name       = "Release 1"
+}
+
+resource "azdo_iteration_path" "sprint" {
+  project_id  = "00000000-0000-0000-0000-000000000000"
+  name        = "Sprint 1"
+  parent_path = azdo_iteration_path.release.path
+  start_date  = "2026-01-05"
+  finish_date = "2026-01-16"
+}
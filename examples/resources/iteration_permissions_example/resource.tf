@@ -0,0 +1,14 @@
+data "azdo_identity" "contributors" {
+  display_name = "[Templates]\\Contributors"
+}
+
+resource "azdo_iteration_permissions" "example" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+  path       = "Templates\\Sprint 1"
+  principal  = data.azdo_identity.contributors.descriptor
+  permissions = {
+    GENERIC_READ    = "Allow"
+    GENERIC_WRITE   = "Deny"
+    CREATE_CHILDREN = "Deny"
+  }
+}
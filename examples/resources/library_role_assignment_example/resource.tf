@@ -0,0 +1,16 @@
+data "azdo_identity" "release_managers" {
+  display_name = "[Templates]\\Release Managers"
+}
+
+resource "azdo_library_role_assignment" "production" {
+  project_id        = "00000000-0000-0000-0000-000000000000"
+  variable_group_id = 42
+  principal         = data.azdo_identity.release_managers.descriptor
+  role              = "Administrator"
+}
+
+resource "azdo_library_role_assignment" "project" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+  principal  = data.azdo_identity.release_managers.descriptor
+  role       = "Reader"
+}
@@ -0,0 +1,21 @@
+data "azdo_policy_types" "example" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+}
+
+locals {
+  file_size_policy_type_id = one([for policy_type in data.azdo_policy_types.example.policy_types : policy_type.id if policy_type.display_name == "File size restriction"])
+}
+
+resource "azdo_policy_configuration" "example" {
+  project_id = data.azdo_policy_types.example.project_id
+  type_id    = local.file_size_policy_type_id
+
+  scope = [{
+    repository_id = "00000000-0000-0000-0000-000000000000"
+  }]
+
+  settings = jsonencode({
+    maximumGitBlobSizeInBytes = 10485760
+    useUncompressedSize       = false
+  })
+}
@@ -0,0 +1,13 @@
+resource "azdo_project" "example" {
+  name             = "Templates"
+  description      = "Pipeline templates shared by all teams"
+  visibility       = "private"
+  version_control  = "Git"
+  process_template = "Agile"
+}
+
+resource "azdo_group_membership" "example" {
+  group      = "[Templates]\\Contributors"
+  members    = ["Kubernetes Build Service (DefaultCollection)"]
+  project_id = azdo_project.example.id
+}
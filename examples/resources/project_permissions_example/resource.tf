@@ -0,0 +1,13 @@
+data "azdo_identity" "readers" {
+  display_name = "[Templates]\\Readers"
+}
+
+resource "azdo_project_permissions" "example" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+  principal  = data.azdo_identity.readers.descriptor
+  permissions = {
+    GENERIC_READ      = "Allow"
+    DELETE            = "Deny"
+    MANAGE_PROPERTIES = "NotSet"
+  }
+}
@@ -0,0 +1,16 @@
+data "azdo_identity" "release_managers" {
+  display_name = "[Templates]\\Release Managers"
+}
+
+resource "azdo_serviceendpoint_role_assignment" "production" {
+  project_id         = "00000000-0000-0000-0000-000000000000"
+  serviceendpoint_id = "00000000-0000-0000-0000-000000000000"
+  principal          = data.azdo_identity.release_managers.descriptor
+  role               = "User"
+}
+
+resource "azdo_serviceendpoint_role_assignment" "project" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+  principal  = data.azdo_identity.release_managers.descriptor
+  role       = "Creator"
+}
@@ -0,0 +1,10 @@
+resource "azdo_team" "example" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+  name       = "Platform"
+}
+
+resource "azdo_team_administrators" "example" {
+  project_id     = azdo_team.example.project_id
+  team_id        = azdo_team.example.id
+  administrators = ["bob@example.com"]
+}
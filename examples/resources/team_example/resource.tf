@@ -0,0 +1,5 @@
+resource "azdo_team" "example" {
+  project_id  = "00000000-0000-0000-0000-000000000000"
+  name        = "Platform"
+  description = "Builds and runs the shared pipelines"
+}
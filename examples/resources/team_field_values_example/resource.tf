@@ -0,0 +1,14 @@
+resource "azdo_team_field_values" "example" {
+  project_id    = "00000000-0000-0000-0000-000000000000"
+  team_id       = "00000000-0000-0000-0000-000000000000"
+  default_value = "Templates\\Platform"
+  values = [
+    {
+      value            = "Templates\\Platform"
+      include_children = true
+    },
+    {
+      value = "Templates\\Shared"
+    },
+  ]
+}
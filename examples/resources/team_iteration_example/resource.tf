@@ -0,0 +1,5 @@
+resource "azdo_team_iteration" "example" {
+  project_id   = "00000000-0000-0000-0000-000000000000"
+  team_id      = "00000000-0000-0000-0000-000000000000"
+  iteration_id = "00000000-0000-0000-0000-000000000000"
+}
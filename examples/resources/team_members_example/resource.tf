@@ -0,0 +1,13 @@
+resource "azdo_team" "example" {
+  project_id = "00000000-0000-0000-0000-000000000000"
+  name       = "Platform"
+}
+
+resource "azdo_team_members" "example" {
+  project_id = azdo_team.example.project_id
+  team_id    = azdo_team.example.id
+  members = [
+    "alice@example.com",
+    "[Templates]\\Contributors",
+  ]
+}
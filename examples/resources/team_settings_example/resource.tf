@@ -0,0 +1,11 @@
+resource "azdo_team_settings" "example" {
+  project_id              = "00000000-0000-0000-0000-000000000000"
+  team_id                 = "00000000-0000-0000-0000-000000000000"
+  backlog_iteration_id    = "00000000-0000-0000-0000-000000000000"
+  default_iteration_macro = "@currentIteration"
+  bugs_behavior           = "asTasks"
+  working_days            = ["monday", "tuesday", "wednesday", "thursday", "friday"]
+  backlog_visibilities = {
+    "Microsoft.EpicCategory" = false
+  }
+}
@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-azdo/services"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccessControlEntryResource{}
+var _ resource.ResourceWithConfigValidators = &AccessControlEntryResource{}
+
+func NewAccessControlEntryResource() resource.Resource {
+	return &AccessControlEntryResource{}
+}
+
+// AccessControlEntryResource defines the resource implementation.
+type AccessControlEntryResource struct {
+	client *security.ClientImpl
+}
+
+// AccessControlEntryResourceModel describes the resource data model.
+type AccessControlEntryResourceModel struct {
+	Id            types.String   `tfsdk:"id"`
+	NamespaceId   types.String   `tfsdk:"namespace_id"`
+	NamespaceName types.String   `tfsdk:"namespace_name"`
+	Token         types.String   `tfsdk:"token"`
+	Principal     types.String   `tfsdk:"principal"`
+	Allow         []types.String `tfsdk:"allow"`
+	Deny          []types.String `tfsdk:"deny"`
+}
+
+func (r *AccessControlEntryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_control_entry"
+}
+
+func (r *AccessControlEntryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Access control entry resource. Manages the entry of a principal on a token in any security namespace",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The security namespace, token and principal of the entry",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the security namespace",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the security namespace, for example `Build`, `CSS` or `Library`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The security token the entry applies to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The descriptor of the identity the entry applies to, see `azdo_identity`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allow": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				MarkdownDescription: "Names of the actions that are allowed",
+			},
+			"deny": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				MarkdownDescription: "Names of the actions that are denied",
+			},
+		},
+	}
+}
+
+func (r *AccessControlEntryResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("namespace_id"),
+			path.MatchRoot("namespace_name"),
+		),
+	}
+}
+
+func (r *AccessControlEntryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.SecurityClient
+}
+
+func (r *AccessControlEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccessControlEntryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	namespace, err := getSecurityNamespace(ctx, securityService, data.NamespaceId, data.NamespaceName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	// Fill in the namespace attribute that was not configured.
+	if data.NamespaceId.IsUnknown() {
+		data.NamespaceId = types.StringValue(namespace.NamespaceId.String())
+	}
+	if data.NamespaceName.IsUnknown() {
+		data.NamespaceName = types.StringPointerValue(namespace.Name)
+	}
+
+	err = r.setAccessControlEntry(ctx, securityService, namespace, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Id = types.StringValue(data.NamespaceId.ValueString() + "/" + permissionsId(data.Token.ValueString(), data.Principal.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccessControlEntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccessControlEntryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	namespace, err := getSecurityNamespace(ctx, securityService, data.NamespaceId, data.NamespaceName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	ace, err := securityService.GetAccessControlEntry(ctx, *namespace.NamespaceId, data.Token.ValueString(), data.Principal.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Allow = actionNamesToModel(namespace, services.GetActionNames(namespace, *ace.Allow), data.Allow)
+	data.Deny = actionNamesToModel(namespace, services.GetActionNames(namespace, *ace.Deny), data.Deny)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccessControlEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccessControlEntryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	namespace, err := getSecurityNamespace(ctx, securityService, data.NamespaceId, data.NamespaceName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	err = r.setAccessControlEntry(ctx, securityService, namespace, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccessControlEntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccessControlEntryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	namespaceId, err := uuid.Parse(data.NamespaceId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	err = securityService.RemoveAccessControlEntry(ctx, namespaceId, data.Token.ValueString(), data.Principal.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+}
+
+func (r *AccessControlEntryResource) setAccessControlEntry(ctx context.Context, securityService *services.SecurityService, namespace *security.SecurityNamespaceDescription, data *AccessControlEntryResourceModel) error {
+	allow, err := services.GetActionsBits(namespace, permissionsFromList(data.Allow))
+	if err != nil {
+		return err
+	}
+	deny, err := services.GetActionsBits(namespace, permissionsFromList(data.Deny))
+	if err != nil {
+		return err
+	}
+	if allow&deny != 0 {
+		return fmt.Errorf("actions %s can not be both allowed and denied", strings.Join(services.GetActionNames(namespace, allow&deny), ", "))
+	}
+
+	if allow == 0 && deny == 0 {
+		return securityService.RemoveAccessControlEntry(ctx, *namespace.NamespaceId, data.Token.ValueString(), data.Principal.ValueString())
+	}
+
+	principal := data.Principal.ValueString()
+	return securityService.SetAccessControlEntry(ctx, *namespace.NamespaceId, data.Token.ValueString(), &security.AccessControlEntry{
+		Descriptor: &principal,
+		Allow:      &allow,
+		Deny:       &deny,
+	})
+}
@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-azdo/services"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccessControlInheritanceResource{}
+var _ resource.ResourceWithConfigValidators = &AccessControlInheritanceResource{}
+
+func NewAccessControlInheritanceResource() resource.Resource {
+	return &AccessControlInheritanceResource{}
+}
+
+// AccessControlInheritanceResource defines the resource implementation.
+type AccessControlInheritanceResource struct {
+	client *security.ClientImpl
+}
+
+// AccessControlInheritanceResourceModel describes the resource data model.
+type AccessControlInheritanceResourceModel struct {
+	Id            types.String                  `tfsdk:"id"`
+	NamespaceId   types.String                  `tfsdk:"namespace_id"`
+	NamespaceName types.String                  `tfsdk:"namespace_name"`
+	Token         types.String                  `tfsdk:"token"`
+	Inherit       types.Bool                    `tfsdk:"inherit"`
+	Entries       []AccessControlListEntryModel `tfsdk:"entries"`
+}
+
+func (r *AccessControlInheritanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_control_inheritance"
+}
+
+func (r *AccessControlInheritanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Access control inheritance resource. Turns the inheritance of permissions from parent tokens on or off, and optionally replaces all entries of the token. Inheritance is turned back on when the resource is destroyed",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The security namespace and token",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the security namespace",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the security namespace, for example `Git Repositories` or `CSS`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The security token, see the token functions of the provider",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"inherit": schema.BoolAttribute{
+				Required:            true,
+				MarkdownDescription: "Whether the token inherits permissions from its parent tokens",
+			},
+			"entries": schema.SetNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "When set, replaces all entries of the token with these entries. Entries are left untouched when not set",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: accessControlListEntriesAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccessControlInheritanceResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("namespace_id"),
+			path.MatchRoot("namespace_name"),
+		),
+	}
+}
+
+func (r *AccessControlInheritanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.SecurityClient
+}
+
+func (r *AccessControlInheritanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccessControlInheritanceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	namespace, err := getSecurityNamespace(ctx, securityService, data.NamespaceId, data.NamespaceName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	// Fill in the namespace attribute that was not configured.
+	if data.NamespaceId.IsUnknown() {
+		data.NamespaceId = types.StringValue(namespace.NamespaceId.String())
+	}
+	if data.NamespaceName.IsUnknown() {
+		data.NamespaceName = types.StringPointerValue(namespace.Name)
+	}
+
+	err = r.setAccessControlList(ctx, securityService, namespace, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Id = types.StringValue(data.NamespaceId.ValueString() + "/" + data.Token.ValueString())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccessControlInheritanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccessControlInheritanceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	namespace, err := getSecurityNamespace(ctx, securityService, data.NamespaceId, data.NamespaceName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	acl, err := securityService.GetAccessControlList(ctx, *namespace.NamespaceId, data.Token.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Inherit = types.BoolValue(*acl.InheritPermissions)
+	if data.Entries != nil {
+		data.Entries = accessControlEntriesToModel(namespace, *acl.AcesDictionary, data.Entries)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccessControlInheritanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccessControlInheritanceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	namespace, err := getSecurityNamespace(ctx, securityService, data.NamespaceId, data.NamespaceName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	err = r.setAccessControlList(ctx, securityService, namespace, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccessControlInheritanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccessControlInheritanceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	namespaceId, err := uuid.Parse(data.NamespaceId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Turn inheritance back on, the entries of the token are left as they are.
+	acl, err := securityService.GetAccessControlList(ctx, namespaceId, data.Token.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	inheritPermissions := true
+	acl.InheritPermissions = &inheritPermissions
+
+	err = securityService.SetAccessControlList(ctx, namespaceId, acl)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+}
+
+func (r *AccessControlInheritanceResource) setAccessControlList(ctx context.Context, securityService *services.SecurityService, namespace *security.SecurityNamespaceDescription, data *AccessControlInheritanceResourceModel) error {
+	acl, err := securityService.GetAccessControlList(ctx, *namespace.NamespaceId, data.Token.ValueString())
+	if err != nil {
+		return err
+	}
+
+	inheritPermissions := data.Inherit.ValueBool()
+	acl.InheritPermissions = &inheritPermissions
+	if data.Entries != nil {
+		aces, err := accessControlEntriesFromModel(namespace, data.Entries)
+		if err != nil {
+			return err
+		}
+		acl.AcesDictionary = &aces
+	}
+
+	return securityService.SetAccessControlList(ctx, *namespace.NamespaceId, acl)
+}
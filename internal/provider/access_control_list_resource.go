@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-azdo/services"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccessControlListResource{}
+var _ resource.ResourceWithConfigValidators = &AccessControlListResource{}
+var _ resource.ResourceWithImportState = &AccessControlListResource{}
+
+func NewAccessControlListResource() resource.Resource {
+	return &AccessControlListResource{}
+}
+
+// AccessControlListResource defines the resource implementation.
+type AccessControlListResource struct {
+	client *security.ClientImpl
+}
+
+// AccessControlListResourceModel describes the resource data model.
+type AccessControlListResourceModel struct {
+	Id            types.String                  `tfsdk:"id"`
+	NamespaceId   types.String                  `tfsdk:"namespace_id"`
+	NamespaceName types.String                  `tfsdk:"namespace_name"`
+	Token         types.String                  `tfsdk:"token"`
+	Inherit       types.Bool                    `tfsdk:"inherit"`
+	Entries       []AccessControlListEntryModel `tfsdk:"entries"`
+}
+
+func (r *AccessControlListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_control_list"
+}
+
+func (r *AccessControlListResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Access control list resource. Owns every entry on a token: entries that are not configured are reported as drift and removed on apply. Do not combine with `azdo_access_control_entry` or the permission resources on the same token",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The security namespace and token",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the security namespace",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the security namespace, for example `Git Repositories` or `CSS`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The security token, see the token functions of the provider",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"inherit": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the token inherits permissions from its parent tokens",
+			},
+			"entries": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "All entries of the token",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: accessControlListEntriesAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccessControlListResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("namespace_id"),
+			path.MatchRoot("namespace_name"),
+		),
+	}
+}
+
+func (r *AccessControlListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.SecurityClient
+}
+
+func (r *AccessControlListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccessControlListResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	namespace, err := getSecurityNamespace(ctx, securityService, data.NamespaceId, data.NamespaceName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	// Fill in the namespace attribute that was not configured.
+	if data.NamespaceId.IsUnknown() {
+		data.NamespaceId = types.StringValue(namespace.NamespaceId.String())
+	}
+	if data.NamespaceName.IsUnknown() {
+		data.NamespaceName = types.StringPointerValue(namespace.Name)
+	}
+
+	err = r.setAccessControlList(ctx, securityService, namespace, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Id = types.StringValue(data.NamespaceId.ValueString() + "/" + data.Token.ValueString())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccessControlListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccessControlListResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	namespace, err := getSecurityNamespace(ctx, securityService, data.NamespaceId, data.NamespaceName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Fill in the namespace attributes after an import.
+	if data.NamespaceId.IsNull() {
+		data.NamespaceId = types.StringValue(namespace.NamespaceId.String())
+	}
+	if data.NamespaceName.IsNull() {
+		data.NamespaceName = types.StringPointerValue(namespace.Name)
+	}
+
+	// Every entry of the token is read, so entries added outside of Terraform show up as drift.
+	acl, err := securityService.GetAccessControlList(ctx, *namespace.NamespaceId, data.Token.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Inherit = types.BoolValue(*acl.InheritPermissions)
+	data.Entries = accessControlEntriesToModel(namespace, *acl.AcesDictionary, data.Entries)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccessControlListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccessControlListResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	namespace, err := getSecurityNamespace(ctx, securityService, data.NamespaceId, data.NamespaceName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	err = r.setAccessControlList(ctx, securityService, namespace, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccessControlListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccessControlListResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	namespaceId, err := uuid.Parse(data.NamespaceId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Removing the access control list removes all entries and turns inheritance back on.
+	err = securityService.RemoveAccessControlList(ctx, namespaceId, data.Token.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+}
+
+func (r *AccessControlListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Access control lists are imported as <namespace ID>/<token>, tokens can contain slashes.
+	namespaceId, token, found := strings.Cut(req.ID, "/")
+	if !found || namespaceId == "" || token == "" {
+		resp.Diagnostics.AddError("Error", fmt.Sprintf("invalid import ID %s, expected <namespace ID>/<token>", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace_id"), namespaceId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("token"), token)...)
+}
+
+func (r *AccessControlListResource) setAccessControlList(ctx context.Context, securityService *services.SecurityService, namespace *security.SecurityNamespaceDescription, data *AccessControlListResourceModel) error {
+	aces, err := accessControlEntriesFromModel(namespace, data.Entries)
+	if err != nil {
+		return err
+	}
+
+	// Setting the access control list replaces all its entries, which removes the unmanaged ones.
+	token := data.Token.ValueString()
+	inheritPermissions := data.Inherit.ValueBool()
+	return securityService.SetAccessControlList(ctx, *namespace.NamespaceId, &security.AccessControlList{
+		Token:              &token,
+		InheritPermissions: &inheritPermissions,
+		AcesDictionary:     &aces,
+	})
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+func NewAgentPoolRoleAssignmentResource() resource.Resource {
+	return &RoleAssignmentResource{
+		name:            "agent_pool",
+		description:     "Azdo Agent pool role assignment resource, assigns a role on an agent pool of the collection",
+		roles:           agentPoolRoles,
+		roleDescription: "The name of the role, `Reader`, `User` or `Administrator`",
+		resourceAttributes: map[string]schema.Attribute{
+			"pool_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the agent pool",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+		importAttributes: []string{"pool_id"},
+		scope: func(values map[string]attr.Value) (string, string) {
+			return services.AgentPoolRoleScope, int64AttributeString(values["pool_id"])
+		},
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewAgentQueueRoleAssignmentResource() resource.Resource {
+	return &RoleAssignmentResource{
+		name:            "agent_queue",
+		description:     "Azdo Agent queue role assignment resource, assigns a role on an agent queue of a project",
+		roles:           agentQueueRoles,
+		roleDescription: "The name of the role, `Reader`, `User`, `Creator` or `Administrator`",
+		resourceAttributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the project",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"queue_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the agent queue of the project",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+		importAttributes: []string{"project_id", "queue_id"},
+		scope: func(values map[string]attr.Value) (string, string) {
+			projectId := values["project_id"].(types.String)
+			return services.AgentQueueRoleScope, services.AgentQueueRoleResourceId(projectId.ValueString(), int64AttributeString(values["queue_id"]))
+		},
+	}
+}
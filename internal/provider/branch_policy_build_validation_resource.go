@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func NewBranchPolicyBuildValidationResource() resource.Resource {
+	return &BranchPolicyResource{policyType: branchPolicyType{
+		name:        "build_validation",
+		description: "Azdo Branch policy resource requiring a successful build to complete pull requests",
+		typeId:      services.BuildValidationPolicyTypeId,
+		settings: map[string]schema.Attribute{
+			"build_definition_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the build definition to queue",
+			},
+			"display_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the policy shown in pull requests, the name of the build definition is shown when not set",
+			},
+			"manual_queue_only": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the build is only queued manually instead of when the source branch is updated",
+			},
+			"queue_on_source_update_only": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the build result expires only when the source branch is updated, instead of also when the target branch is updated",
+			},
+			"valid_duration": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(720),
+				MarkdownDescription: "The number of minutes the build result stays valid, 0 when it never expires",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"filename_patterns": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Paths that trigger the build when changed, for example `/src/*` or `!/docs/*` to exclude a path",
+			},
+		},
+		writeSettings: writeBuildValidationSettings,
+		readSettings:  readBuildValidationSettings,
+	}}
+}
+
+type BranchPolicyBuildValidationSettingsModel struct {
+	BuildDefinitionId       types.Int64    `tfsdk:"build_definition_id"`
+	DisplayName             types.String   `tfsdk:"display_name"`
+	ManualQueueOnly         types.Bool     `tfsdk:"manual_queue_only"`
+	QueueOnSourceUpdateOnly types.Bool     `tfsdk:"queue_on_source_update_only"`
+	ValidDuration           types.Int64    `tfsdk:"valid_duration"`
+	FilenamePatterns        []types.String `tfsdk:"filename_patterns"`
+}
+
+func writeBuildValidationSettings(ctx context.Context, r *BranchPolicyResource, settings types.Object, document map[string]interface{}) diag.Diagnostics {
+	var model BranchPolicyBuildValidationSettingsModel
+	diags := settings.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+
+	document["buildDefinitionId"] = model.BuildDefinitionId.ValueInt64()
+	if !model.DisplayName.IsNull() {
+		document["displayName"] = model.DisplayName.ValueString()
+	}
+	document["manualQueueOnly"] = model.ManualQueueOnly.ValueBool()
+	document["queueOnSourceUpdateOnly"] = model.QueueOnSourceUpdateOnly.ValueBool()
+	document["validDuration"] = model.ValidDuration.ValueInt64()
+	if model.FilenamePatterns != nil {
+		document["filenamePatterns"] = documentStringsFromList(model.FilenamePatterns)
+	}
+	return diags
+}
+
+func readBuildValidationSettings(ctx context.Context, r *BranchPolicyResource, document map[string]interface{}, prior types.Object) (types.Object, diag.Diagnostics) {
+	var priorModel BranchPolicyBuildValidationSettingsModel
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags := prior.As(ctx, &priorModel, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return prior, diags
+		}
+	}
+
+	model := BranchPolicyBuildValidationSettingsModel{
+		BuildDefinitionId:       documentInt64(document, "buildDefinitionId"),
+		DisplayName:             documentString(document, "displayName"),
+		ManualQueueOnly:         documentBool(document, "manualQueueOnly"),
+		QueueOnSourceUpdateOnly: documentBool(document, "queueOnSourceUpdateOnly"),
+		ValidDuration:           documentInt64(document, "validDuration"),
+		FilenamePatterns:        documentStrings(document, "filenamePatterns", priorModel.FilenamePatterns),
+	}
+	return types.ObjectValueFrom(ctx, r.settingsAttributeTypes(), model)
+}
@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewBranchPolicyCommentResolutionResource() resource.Resource {
+	return &BranchPolicyResource{policyType: branchPolicyType{
+		name:        "comment_resolution",
+		description: "Azdo Branch policy resource requiring all comments to be resolved to complete pull requests",
+		typeId:      services.CommentResolutionPolicyTypeId,
+	}}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func NewBranchPolicyMergeTypesResource() resource.Resource {
+	return &BranchPolicyResource{policyType: branchPolicyType{
+		name:        "merge_types",
+		description: "Azdo Branch policy resource limiting the merge types allowed to complete pull requests",
+		typeId:      services.MergeTypesPolicyTypeId,
+		settings: map[string]schema.Attribute{
+			"allow_no_fast_forward": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether a basic merge (no fast-forward) is allowed",
+			},
+			"allow_squash": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether a squash merge is allowed",
+			},
+			"allow_rebase": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether a rebase and fast-forward is allowed",
+			},
+			"allow_rebase_merge": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether a rebase with merge commit (semi-linear merge) is allowed",
+			},
+		},
+		writeSettings: writeMergeTypesSettings,
+		readSettings:  readMergeTypesSettings,
+	}}
+}
+
+type BranchPolicyMergeTypesSettingsModel struct {
+	AllowNoFastForward types.Bool `tfsdk:"allow_no_fast_forward"`
+	AllowSquash        types.Bool `tfsdk:"allow_squash"`
+	AllowRebase        types.Bool `tfsdk:"allow_rebase"`
+	AllowRebaseMerge   types.Bool `tfsdk:"allow_rebase_merge"`
+}
+
+func writeMergeTypesSettings(ctx context.Context, r *BranchPolicyResource, settings types.Object, document map[string]interface{}) diag.Diagnostics {
+	var model BranchPolicyMergeTypesSettingsModel
+	diags := settings.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+
+	if !model.AllowNoFastForward.ValueBool() && !model.AllowSquash.ValueBool() && !model.AllowRebase.ValueBool() && !model.AllowRebaseMerge.ValueBool() {
+		diags.AddError("Error", "at least one merge type must be allowed")
+		return diags
+	}
+
+	document["allowNoFastForward"] = model.AllowNoFastForward.ValueBool()
+	document["allowSquash"] = model.AllowSquash.ValueBool()
+	document["allowRebase"] = model.AllowRebase.ValueBool()
+	document["allowRebaseMerge"] = model.AllowRebaseMerge.ValueBool()
+	return diags
+}
+
+func readMergeTypesSettings(ctx context.Context, r *BranchPolicyResource, document map[string]interface{}, prior types.Object) (types.Object, diag.Diagnostics) {
+	model := BranchPolicyMergeTypesSettingsModel{
+		AllowNoFastForward: documentBool(document, "allowNoFastForward"),
+		AllowSquash:        documentBool(document, "allowSquash"),
+		AllowRebase:        documentBool(document, "allowRebase"),
+		AllowRebaseMerge:   documentBool(document, "allowRebaseMerge"),
+	}
+	return types.ObjectValueFrom(ctx, r.settingsAttributeTypes(), model)
+}
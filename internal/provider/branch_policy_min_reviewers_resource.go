@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func NewBranchPolicyMinReviewersResource() resource.Resource {
+	return &BranchPolicyResource{policyType: branchPolicyType{
+		name:        "min_reviewers",
+		description: "Azdo Branch policy resource requiring a minimum number of reviewers to approve pull requests",
+		typeId:      services.MinimumReviewersPolicyTypeId,
+		settings: map[string]schema.Attribute{
+			"minimum_approver_count": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The number of reviewers that must approve",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"creator_vote_counts": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the vote of the creator of the pull request counts",
+			},
+			"allow_downvotes": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether pull requests can be completed when some reviewers vote to wait or reject",
+			},
+			"reset_on_source_push": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether all votes are reset when new changes are pushed",
+			},
+			"reset_rejections_on_source_push": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether votes to wait or reject are reset when new changes are pushed",
+			},
+			"require_vote_on_last_iteration": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether at least one approval is required on the most recent iteration",
+			},
+			"block_last_pusher_vote": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the most recent pusher is prohibited from approving their own changes",
+			},
+		},
+		writeSettings: writeMinReviewersSettings,
+		readSettings:  readMinReviewersSettings,
+	}}
+}
+
+type BranchPolicyMinReviewersSettingsModel struct {
+	MinimumApproverCount        types.Int64 `tfsdk:"minimum_approver_count"`
+	CreatorVoteCounts           types.Bool  `tfsdk:"creator_vote_counts"`
+	AllowDownvotes              types.Bool  `tfsdk:"allow_downvotes"`
+	ResetOnSourcePush           types.Bool  `tfsdk:"reset_on_source_push"`
+	ResetRejectionsOnSourcePush types.Bool  `tfsdk:"reset_rejections_on_source_push"`
+	RequireVoteOnLastIteration  types.Bool  `tfsdk:"require_vote_on_last_iteration"`
+	BlockLastPusherVote         types.Bool  `tfsdk:"block_last_pusher_vote"`
+}
+
+func writeMinReviewersSettings(ctx context.Context, r *BranchPolicyResource, settings types.Object, document map[string]interface{}) diag.Diagnostics {
+	var model BranchPolicyMinReviewersSettingsModel
+	diags := settings.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+
+	document["minimumApproverCount"] = model.MinimumApproverCount.ValueInt64()
+	document["creatorVoteCounts"] = model.CreatorVoteCounts.ValueBool()
+	document["allowDownvotes"] = model.AllowDownvotes.ValueBool()
+	document["resetOnSourcePush"] = model.ResetOnSourcePush.ValueBool()
+	document["resetRejectionsOnSourcePush"] = model.ResetRejectionsOnSourcePush.ValueBool()
+	document["requireVoteOnLastIteration"] = model.RequireVoteOnLastIteration.ValueBool()
+	document["blockLastPusherVote"] = model.BlockLastPusherVote.ValueBool()
+	return diags
+}
+
+func readMinReviewersSettings(ctx context.Context, r *BranchPolicyResource, document map[string]interface{}, prior types.Object) (types.Object, diag.Diagnostics) {
+	model := BranchPolicyMinReviewersSettingsModel{
+		MinimumApproverCount:        documentInt64(document, "minimumApproverCount"),
+		CreatorVoteCounts:           documentBool(document, "creatorVoteCounts"),
+		AllowDownvotes:              documentBool(document, "allowDownvotes"),
+		ResetOnSourcePush:           documentBool(document, "resetOnSourcePush"),
+		ResetRejectionsOnSourcePush: documentBool(document, "resetRejectionsOnSourcePush"),
+		RequireVoteOnLastIteration:  documentBool(document, "requireVoteOnLastIteration"),
+		BlockLastPusherVote:         documentBool(document, "blockLastPusherVote"),
+	}
+	return types.ObjectValueFrom(ctx, r.settingsAttributeTypes(), model)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func NewBranchPolicyRequiredReviewersResource() resource.Resource {
+	return &BranchPolicyResource{policyType: branchPolicyType{
+		name:        "required_reviewers",
+		description: "Azdo Branch policy resource automatically adding required reviewers to pull requests",
+		typeId:      services.RequiredReviewersPolicyTypeId,
+		settings: map[string]schema.Attribute{
+			"required_reviewer_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "The IDs, descriptors or subject descriptors of the users and groups to add as reviewers, see `azdo_identity`",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"minimum_approver_count": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "The number of the required reviewers that must approve, members of a group count individually",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"creator_vote_counts": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the vote of the creator of the pull request counts",
+			},
+			"filename_patterns": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Paths that require the reviewers when changed, for example `/src/*` or `!/docs/*` to exclude a path",
+			},
+			"message": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The message shown in the activity feed of pull requests",
+			},
+		},
+		writeSettings: writeRequiredReviewersSettings,
+		readSettings:  readRequiredReviewersSettings,
+	}}
+}
+
+type BranchPolicyRequiredReviewersSettingsModel struct {
+	RequiredReviewerIds  []types.String `tfsdk:"required_reviewer_ids"`
+	MinimumApproverCount types.Int64    `tfsdk:"minimum_approver_count"`
+	CreatorVoteCounts    types.Bool     `tfsdk:"creator_vote_counts"`
+	FilenamePatterns     []types.String `tfsdk:"filename_patterns"`
+	Message              types.String   `tfsdk:"message"`
+}
+
+func writeRequiredReviewersSettings(ctx context.Context, r *BranchPolicyResource, settings types.Object, document map[string]interface{}) diag.Diagnostics {
+	var model BranchPolicyRequiredReviewersSettingsModel
+	diags := settings.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+
+	reviewerIds, err := resolveReviewerIds(ctx, r, model.RequiredReviewerIds)
+	if err != nil {
+		diags.AddError("Error", err.Error())
+		return diags
+	}
+
+	document["requiredReviewerIds"] = reviewerIds
+	document["minimumApproverCount"] = model.MinimumApproverCount.ValueInt64()
+	document["creatorVoteCounts"] = model.CreatorVoteCounts.ValueBool()
+	if model.FilenamePatterns != nil {
+		document["filenamePatterns"] = documentStringsFromList(model.FilenamePatterns)
+	}
+	if !model.Message.IsNull() {
+		document["message"] = model.Message.ValueString()
+	}
+	return diags
+}
+
+func readRequiredReviewersSettings(ctx context.Context, r *BranchPolicyResource, document map[string]interface{}, prior types.Object) (types.Object, diag.Diagnostics) {
+	var priorModel BranchPolicyRequiredReviewersSettingsModel
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags := prior.As(ctx, &priorModel, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return prior, diags
+		}
+	}
+
+	model := BranchPolicyRequiredReviewersSettingsModel{
+		RequiredReviewerIds:  documentStrings(document, "requiredReviewerIds", []types.String{}),
+		MinimumApproverCount: documentInt64(document, "minimumApproverCount"),
+		CreatorVoteCounts:    documentBool(document, "creatorVoteCounts"),
+		FilenamePatterns:     documentStrings(document, "filenamePatterns", priorModel.FilenamePatterns),
+		Message:              documentString(document, "message"),
+	}
+	if priorModel.Message.IsNull() && model.Message.ValueString() == "" {
+		model.Message = types.StringNull()
+	}
+
+	// Keep the configured descriptors when they still resolve to the reviewers of the policy.
+	if len(priorModel.RequiredReviewerIds) > 0 {
+		priorIds, err := resolveReviewerIds(ctx, r, priorModel.RequiredReviewerIds)
+		if err == nil && sameIdentityIds(priorIds, documentStringsFromList(model.RequiredReviewerIds)) {
+			model.RequiredReviewerIds = priorModel.RequiredReviewerIds
+		}
+	}
+
+	return types.ObjectValueFrom(ctx, r.settingsAttributeTypes(), model)
+}
+
+// resolveReviewerIds resolves the configured reviewers, which can be identity
+// IDs or descriptors, to identity IDs.
+func resolveReviewerIds(ctx context.Context, r *BranchPolicyResource, reviewers []types.String) ([]string, error) {
+	identityService := services.NewIdentityService(r.identityClient)
+
+	ids := make([]string, 0, len(reviewers))
+	for _, reviewer := range reviewers {
+		id, err := identityService.ResolveIdentityId(ctx, reviewer.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// sameIdentityIds reports whether both lists hold the same identity IDs, in any order.
+func sameIdentityIds(a []string, b []string) bool {
+	normalize := func(ids []string) []string {
+		normalized := make([]string, 0, len(ids))
+		for _, id := range ids {
+			normalized = append(normalized, strings.ToLower(id))
+		}
+		slices.Sort(normalized)
+		return slices.Compact(normalized)
+	}
+	return slices.Equal(normalize(a), normalize(b))
+}
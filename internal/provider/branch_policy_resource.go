@@ -0,0 +1,492 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-azdo/services"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BranchPolicyResource{}
+var _ resource.ResourceWithImportState = &BranchPolicyResource{}
+
+// Match types of the scope of a branch policy.
+var branchPolicyMatchTypes = []string{"Exact", "Prefix", "DefaultBranch"}
+
+// BranchPolicyResource defines the resource implementation shared by the
+// branch policy resources, which only differ in their policy type and settings.
+type BranchPolicyResource struct {
+	client         *policy.ClientImpl
+	identityClient *identity.ClientImpl
+	policyType     branchPolicyType
+}
+
+// branchPolicyType describes one type of branch policy.
+type branchPolicyType struct {
+	// name is appended to the resource type name, for example min_reviewers.
+	name        string
+	description string
+	typeId      uuid.UUID
+	// settings are the attributes of the settings of the policy, nil when the
+	// policy has no settings.
+	settings map[string]schema.Attribute
+	// writeSettings copies the settings of the model into the settings document of the policy.
+	writeSettings func(ctx context.Context, r *BranchPolicyResource, settings types.Object, document map[string]interface{}) diag.Diagnostics
+	// readSettings reads the settings of the model from the settings document
+	// of the policy, prior holds the settings currently in state.
+	readSettings func(ctx context.Context, r *BranchPolicyResource, document map[string]interface{}, prior types.Object) (types.Object, diag.Diagnostics)
+}
+
+// BranchPolicyResourceModel describes the resource data model.
+type BranchPolicyResourceModel struct {
+	Id        types.String             `tfsdk:"id"`
+	ProjectId types.String             `tfsdk:"project_id"`
+	Enabled   types.Bool               `tfsdk:"enabled"`
+	Blocking  types.Bool               `tfsdk:"blocking"`
+	Scopes    []BranchPolicyScopeModel `tfsdk:"scope"`
+	Settings  types.Object             `tfsdk:"settings"`
+}
+
+// branchPolicyWithoutSettingsModel describes the data model of the branch
+// policies that have no settings.
+type branchPolicyWithoutSettingsModel struct {
+	Id        types.String             `tfsdk:"id"`
+	ProjectId types.String             `tfsdk:"project_id"`
+	Enabled   types.Bool               `tfsdk:"enabled"`
+	Blocking  types.Bool               `tfsdk:"blocking"`
+	Scopes    []BranchPolicyScopeModel `tfsdk:"scope"`
+}
+
+type BranchPolicyScopeModel struct {
+	RepositoryId types.String `tfsdk:"repository_id"`
+	RefName      types.String `tfsdk:"ref_name"`
+	MatchType    types.String `tfsdk:"match_type"`
+}
+
+func (r *BranchPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch_policy_" + r.policyType.name
+}
+
+func (r *BranchPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the policy configuration",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"project_id": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The ID of the project",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+			MarkdownDescription: "Whether the policy is enabled",
+		},
+		"blocking": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+			MarkdownDescription: "Whether the policy is required to complete pull requests, the policy is optional when false",
+		},
+		"scope": schema.ListNestedAttribute{
+			Required:            true,
+			MarkdownDescription: "The repositories and branches the policy applies to",
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"repository_id": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The ID of the repository, the policy applies to all repositories of the project when not set",
+					},
+					"ref_name": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The name of the branch, for example `refs/heads/main`, or the prefix of the branch names when `match_type` is `Prefix`. Not used when `match_type` is `DefaultBranch`",
+					},
+					"match_type": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("Exact"),
+						MarkdownDescription: "How `ref_name` is matched: `Exact`, `Prefix` or `DefaultBranch`",
+						Validators: []validator.String{
+							stringvalidator.OneOf(branchPolicyMatchTypes...),
+						},
+					},
+				},
+			},
+		},
+	}
+	if r.policyType.settings != nil {
+		attributes["settings"] = schema.SingleNestedAttribute{
+			Required:            true,
+			MarkdownDescription: "The settings of the policy",
+			Attributes:          r.policyType.settings,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: r.policyType.description,
+
+		Attributes: attributes,
+	}
+}
+
+func (r *BranchPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.PolicyClient
+	r.identityClient = clients.IdentityClient
+}
+
+func (r *BranchPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BranchPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(r.getModel(ctx, req.Plan, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, diags := r.newConfiguration(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyService := services.NewPolicyService(r.client)
+
+	configuration, err := policyService.CreatePolicyConfiguration(ctx, data.ProjectId.ValueString(), configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Id = types.StringValue(strconv.Itoa(*configuration.Id))
+	resp.Diagnostics.Append(r.readConfiguration(ctx, &data, configuration)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(r.setModel(ctx, &resp.State, &data)...)
+}
+
+func (r *BranchPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BranchPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(r.getModel(ctx, req.State, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configurationId, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", fmt.Sprintf("invalid policy configuration ID %s: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	policyService := services.NewPolicyService(r.client)
+
+	configuration, err := policyService.GetPolicyConfiguration(ctx, data.ProjectId.ValueString(), configurationId)
+	if services.IsNotFound(err) || (err == nil && configuration.IsDeleted != nil && *configuration.IsDeleted) {
+		tflog.Info(ctx, fmt.Sprintf("Policy configuration %d no longer exists, removing it from state", configurationId))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	if configuration.Type == nil || configuration.Type.Id == nil || *configuration.Type.Id != r.policyType.typeId {
+		resp.Diagnostics.AddError("Error", fmt.Sprintf("policy configuration %d is not a %s policy", configurationId, r.policyType.name))
+		return
+	}
+	resp.Diagnostics.Append(r.readConfiguration(ctx, &data, configuration)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(r.setModel(ctx, &resp.State, &data)...)
+}
+
+func (r *BranchPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BranchPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(r.getModel(ctx, req.Plan, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configurationId, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", fmt.Sprintf("invalid policy configuration ID %s: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	configuration, diags := r.newConfiguration(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyService := services.NewPolicyService(r.client)
+
+	configuration, err = policyService.UpdatePolicyConfiguration(ctx, data.ProjectId.ValueString(), configurationId, configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.readConfiguration(ctx, &data, configuration)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(r.setModel(ctx, &resp.State, &data)...)
+}
+
+func (r *BranchPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BranchPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(r.getModel(ctx, req.State, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configurationId, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", fmt.Sprintf("invalid policy configuration ID %s: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	policyService := services.NewPolicyService(r.client)
+
+	err = policyService.DeletePolicyConfiguration(ctx, data.ProjectId.ValueString(), configurationId)
+	if err != nil && !services.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+}
+
+func (r *BranchPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Policies are imported as <project ID>/<policy configuration ID>.
+	projectId, configurationId, found := strings.Cut(req.ID, "/")
+	if !found || projectId == "" || configurationId == "" {
+		resp.Diagnostics.AddError("Error", fmt.Sprintf("invalid import ID %s, expected <project ID>/<policy configuration ID>", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), configurationId)...)
+}
+
+// getModel reads the plan or state into the model, whether the policy has settings or not.
+func (r *BranchPolicyResource) getModel(ctx context.Context, source interface {
+	Get(ctx context.Context, target interface{}) diag.Diagnostics
+}, data *BranchPolicyResourceModel) diag.Diagnostics {
+	if r.policyType.settings != nil {
+		return source.Get(ctx, data)
+	}
+
+	var model branchPolicyWithoutSettingsModel
+	diags := source.Get(ctx, &model)
+	*data = BranchPolicyResourceModel{
+		Id:        model.Id,
+		ProjectId: model.ProjectId,
+		Enabled:   model.Enabled,
+		Blocking:  model.Blocking,
+		Scopes:    model.Scopes,
+	}
+	return diags
+}
+
+// setModel saves the model into the state, whether the policy has settings or not.
+func (r *BranchPolicyResource) setModel(ctx context.Context, state *tfsdk.State, data *BranchPolicyResourceModel) diag.Diagnostics {
+	if r.policyType.settings != nil {
+		return state.Set(ctx, data)
+	}
+
+	return state.Set(ctx, &branchPolicyWithoutSettingsModel{
+		Id:        data.Id,
+		ProjectId: data.ProjectId,
+		Enabled:   data.Enabled,
+		Blocking:  data.Blocking,
+		Scopes:    data.Scopes,
+	})
+}
+
+// settingsAttributeTypes returns the attribute types of the settings object.
+func (r *BranchPolicyResource) settingsAttributeTypes() map[string]attr.Type {
+	settingsType := schema.SingleNestedAttribute{Attributes: r.policyType.settings}.GetType()
+	return settingsType.(types.ObjectType).AttrTypes
+}
+
+func (r *BranchPolicyResource) newConfiguration(ctx context.Context, data *BranchPolicyResourceModel) (*policy.PolicyConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	document := map[string]interface{}{
+		"scope": branchPolicyScopesToDocument(data.Scopes),
+	}
+	if r.policyType.writeSettings != nil {
+		diags.Append(r.policyType.writeSettings(ctx, r, data.Settings, document)...)
+	}
+
+	typeId := r.policyType.typeId
+	return &policy.PolicyConfiguration{
+		Type:       &policy.PolicyTypeRef{Id: &typeId},
+		IsEnabled:  data.Enabled.ValueBoolPointer(),
+		IsBlocking: data.Blocking.ValueBoolPointer(),
+		Settings:   document,
+	}, diags
+}
+
+func (r *BranchPolicyResource) readConfiguration(ctx context.Context, data *BranchPolicyResourceModel, configuration *policy.PolicyConfiguration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Enabled = types.BoolValue(configuration.IsEnabled != nil && *configuration.IsEnabled)
+	data.Blocking = types.BoolValue(configuration.IsBlocking != nil && *configuration.IsBlocking)
+
+	document, _ := configuration.Settings.(map[string]interface{})
+	data.Scopes = branchPolicyScopesFromDocument(document, data.Scopes)
+	if r.policyType.readSettings != nil {
+		var settingsDiags diag.Diagnostics
+		data.Settings, settingsDiags = r.policyType.readSettings(ctx, r, document, data.Settings)
+		diags.Append(settingsDiags...)
+	}
+
+	return diags
+}
+
+func branchPolicyScopesToDocument(scopes []BranchPolicyScopeModel) []interface{} {
+	document := make([]interface{}, 0, len(scopes))
+	for _, scope := range scopes {
+		// A null repository applies the policy to every repository of the project.
+		entry := map[string]interface{}{
+			"repositoryId": nil,
+			"matchKind":    scope.MatchType.ValueString(),
+		}
+		if !scope.RepositoryId.IsNull() {
+			entry["repositoryId"] = scope.RepositoryId.ValueString()
+		}
+		if !scope.RefName.IsNull() {
+			entry["refName"] = scope.RefName.ValueString()
+		}
+		document = append(document, entry)
+	}
+	return document
+}
+
+func branchPolicyScopesFromDocument(document map[string]interface{}, prior []BranchPolicyScopeModel) []BranchPolicyScopeModel {
+	entries, _ := document["scope"].([]interface{})
+	scopes := make([]BranchPolicyScopeModel, 0, len(entries))
+	for i, entry := range entries {
+		values, _ := entry.(map[string]interface{})
+		scope := BranchPolicyScopeModel{
+			RepositoryId: documentString(values, "repositoryId"),
+			RefName:      documentString(values, "refName"),
+			MatchType:    documentString(values, "matchKind"),
+		}
+		// Keep the configured spelling when the scope did not change.
+		if i < len(prior) {
+			if strings.EqualFold(scope.RepositoryId.ValueString(), prior[i].RepositoryId.ValueString()) {
+				scope.RepositoryId = prior[i].RepositoryId
+			}
+			if strings.EqualFold(scope.MatchType.ValueString(), prior[i].MatchType.ValueString()) {
+				scope.MatchType = prior[i].MatchType
+			}
+		}
+		scopes = append(scopes, scope)
+	}
+	return scopes
+}
+
+// documentString returns a string value of a policy settings document, null when it is not set.
+func documentString(document map[string]interface{}, key string) types.String {
+	value, ok := document[key].(string)
+	if !ok {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// documentBool returns a boolean value of a policy settings document, false when it is not set.
+func documentBool(document map[string]interface{}, key string) types.Bool {
+	value, _ := document[key].(bool)
+	return types.BoolValue(value)
+}
+
+// documentInt64 returns a number value of a policy settings document, 0 when it is not set.
+func documentInt64(document map[string]interface{}, key string) types.Int64 {
+	value, _ := document[key].(float64)
+	return types.Int64Value(int64(value))
+}
+
+// documentStrings returns a list of strings of a policy settings document. The
+// prior value is kept when the list is empty and was not configured.
+func documentStrings(document map[string]interface{}, key string, prior []types.String) []types.String {
+	values, _ := document[key].([]interface{})
+	if len(values) == 0 && prior == nil {
+		return nil
+	}
+	list := make([]types.String, 0, len(values))
+	for _, value := range values {
+		if value, ok := value.(string); ok {
+			list = append(list, types.StringValue(value))
+		}
+	}
+	return list
+}
+
+// documentStringsFromList converts a list of strings of the model to a list of a policy settings document.
+func documentStringsFromList(list []types.String) []string {
+	values := make([]string, 0, len(list))
+	for _, value := range list {
+		values = append(values, value.ValueString())
+	}
+	return values
+}
@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewBranchPolicyWorkItemLinkingResource() resource.Resource {
+	return &BranchPolicyResource{policyType: branchPolicyType{
+		name:        "work_item_linking",
+		description: "Azdo Branch policy resource requiring linked work items to complete pull requests",
+		typeId:      services.WorkItemLinkingPolicyTypeId,
+	}}
+}
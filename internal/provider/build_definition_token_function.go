@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &BuildDefinitionTokenFunction{}
+
+func NewBuildDefinitionTokenFunction() function.Function {
+	return &BuildDefinitionTokenFunction{}
+}
+
+// BuildDefinitionTokenFunction defines the function implementation.
+type BuildDefinitionTokenFunction struct{}
+
+func (f *BuildDefinitionTokenFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_definition_token"
+}
+
+func (f *BuildDefinitionTokenFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Security token of a build definition",
+		MarkdownDescription: "Returns the security token of a build definition in the `Build` security namespace. Build definition tokens include the folder the definition is in",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "project_id",
+				MarkdownDescription: "The ID of the project",
+			},
+			function.StringParameter{
+				Name:                "folder_path",
+				MarkdownDescription: "The path of the folder the definition is in, for example `\\Team\\Production` or `\\` for the root folder",
+			},
+			function.StringParameter{
+				Name:                "definition_id",
+				MarkdownDescription: "The ID of the build definition",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *BuildDefinitionTokenFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var projectId, folderPath, definitionId string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &projectId, &folderPath, &definitionId))
+	if resp.Error != nil {
+		return
+	}
+	if projectId == "" {
+		resp.Error = function.NewArgumentFuncError(0, "project_id must not be empty")
+		return
+	}
+	if definitionId == "" {
+		resp.Error = function.NewArgumentFuncError(2, "definition_id must not be empty")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, services.BuildToken(projectId, folderPath, definitionId)))
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &BuildFolderTokenFunction{}
+
+func NewBuildFolderTokenFunction() function.Function {
+	return &BuildFolderTokenFunction{}
+}
+
+// BuildFolderTokenFunction defines the function implementation.
+type BuildFolderTokenFunction struct{}
+
+func (f *BuildFolderTokenFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_folder_token"
+}
+
+func (f *BuildFolderTokenFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Security token of a pipeline folder",
+		MarkdownDescription: "Returns the security token of a pipeline folder in the `Build` security namespace, or of all pipelines of the project when `folder_path` is empty or `\\`",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "project_id",
+				MarkdownDescription: "The ID of the project",
+			},
+			function.StringParameter{
+				Name:                "folder_path",
+				MarkdownDescription: "The path of the folder, for example `\\Team\\Production`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *BuildFolderTokenFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var projectId, folderPath string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &projectId, &folderPath))
+	if resp.Error != nil {
+		return
+	}
+	if projectId == "" {
+		resp.Error = function.NewArgumentFuncError(0, "project_id must not be empty")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, services.BuildToken(projectId, folderPath, "")))
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strconv"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewBuildPermissionsResource() resource.Resource {
+	return &PermissionsResource{
+		name:        "build",
+		description: "Azdo Build permissions resource. Applies to all pipelines of a project, to the pipelines of a folder, or to a single pipeline",
+		namespaceId: services.BuildNamespaceId,
+		examples:    "`QueueBuilds`, `EditBuildDefinition` or `AdministerBuildPermissions`",
+		tokenAttributes: map[string]schema.Attribute{
+			"folder_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The path of the folder, for example `\\Team\\Production`. The permissions apply to all pipelines of the project when neither `folder_path` nor `definition_id` is set",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"definition_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the build definition. `folder_path` must be set to the folder the definition is in, unless it is in the root folder",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+		token: func(projectId string, values map[string]attr.Value) string {
+			folderPath := values["folder_path"].(types.String)
+			definitionId := ""
+			if value := values["definition_id"].(types.Int64); !value.IsNull() {
+				definitionId = strconv.FormatInt(value.ValueInt64(), 10)
+			}
+			return services.BuildToken(projectId, folderPath.ValueString(), definitionId)
+		},
+	}
+}
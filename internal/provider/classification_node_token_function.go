@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ClassificationNodeTokenFunction{}
+
+func NewClassificationNodeTokenFunction() function.Function {
+	return &ClassificationNodeTokenFunction{}
+}
+
+// ClassificationNodeTokenFunction defines the function implementation.
+type ClassificationNodeTokenFunction struct{}
+
+func (f *ClassificationNodeTokenFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "classification_node_token"
+}
+
+func (f *ClassificationNodeTokenFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Security token of an area or iteration path",
+		MarkdownDescription: "Returns the security token of an area path in the `CSS` security namespace or of an iteration path in the `Iteration` security namespace. The token is made of the identifiers of every node of the path, starting with the root node of the project",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "node_ids",
+				ElementType:         types.StringType,
+				MarkdownDescription: "The identifiers of the nodes from the root node of the project down to the area or iteration",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ClassificationNodeTokenFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var nodeIds []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &nodeIds))
+	if resp.Error != nil {
+		return
+	}
+	if len(nodeIds) == 0 {
+		resp.Error = function.NewArgumentFuncError(0, "node_ids must contain at least the root node")
+		return
+	}
+	for _, nodeId := range nodeIds {
+		if nodeId == "" {
+			resp.Error = function.NewArgumentFuncError(0, "node_ids must not contain empty identifiers")
+			return
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, services.ClassificationNodeToken(nodeIds)))
+}
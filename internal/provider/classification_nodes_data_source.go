@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtracking"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ClassificationNodesDataSource{}
+
+func NewClassificationNodesDataSource() datasource.DataSource {
+	log.Println("NewClassificationNodesDataSource")
+	return &ClassificationNodesDataSource{}
+}
+
+// ClassificationNodesDataSource defines the data source implementation.
+type ClassificationNodesDataSource struct {
+	client *workitemtracking.ClientImpl
+}
+
+// ClassificationNodesDataSourceModel describes the data source data model.
+type ClassificationNodesDataSourceModel struct {
+	ProjectId      types.String              `tfsdk:"project_id"`
+	StructureGroup types.String              `tfsdk:"structure_group"`
+	Nodes          []ClassificationNodeModel `tfsdk:"nodes"`
+}
+
+type ClassificationNodeModel struct {
+	Id          types.String   `tfsdk:"id"`
+	NodeId      types.Int64    `tfsdk:"node_id"`
+	Name        types.String   `tfsdk:"name"`
+	Path        types.String   `tfsdk:"path"`
+	ParentId    types.String   `tfsdk:"parent_id"`
+	NodeIds     []types.String `tfsdk:"node_ids"`
+	Token       types.String   `tfsdk:"token"`
+	HasChildren types.Bool     `tfsdk:"has_children"`
+	StartDate   types.String   `tfsdk:"start_date"`
+	FinishDate  types.String   `tfsdk:"finish_date"`
+}
+
+func (d *ClassificationNodesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_classification_nodes"
+}
+
+func (d *ClassificationNodesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Area or iteration paths of a project, the whole tree from the root node down",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the project",
+			},
+			"structure_group": schema.StringAttribute{
+				Required:    true,
+				Description: "The paths to return, areas or iterations",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(workitemtracking.TreeStructureGroupValues.Areas),
+						string(workitemtracking.TreeStructureGroupValues.Iterations),
+					),
+				},
+			},
+			"nodes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The nodes of the tree, every parent before its children",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The identifier of the node",
+						},
+						"node_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The integer ID of the node",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the node",
+						},
+						"path": schema.StringAttribute{
+							Computed:    true,
+							Description: "The path of the node, starting with the root node of the project",
+						},
+						"parent_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The identifier of the parent node, empty for the root node",
+						},
+						"node_ids": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "The identifiers of the nodes from the root node down to the node",
+						},
+						"token": schema.StringAttribute{
+							Computed:    true,
+							Description: "The security token of the node in the CSS or Iteration namespace",
+						},
+						"has_children": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the node has child nodes",
+						},
+						"start_date": schema.StringAttribute{
+							Computed:    true,
+							Description: "The start date of the iteration formatted as YYYY-MM-DD, empty when not set",
+						},
+						"finish_date": schema.StringAttribute{
+							Computed:    true,
+							Description: "The finish date of the iteration formatted as YYYY-MM-DD, empty when not set",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ClassificationNodesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	log.Println("Configure ClassificationNodesDataSource")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.WorkItemTrackingClient
+}
+
+func (d *ClassificationNodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClassificationNodesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	classificationService := services.NewClassificationService(d.client)
+
+	root, err := classificationService.GetClassificationTree(ctx, data.ProjectId.ValueString(), workitemtracking.TreeStructureGroup(data.StructureGroup.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	data.Nodes = []ClassificationNodeModel{}
+	appendClassificationNodes(&data.Nodes, root, []string{})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// appendClassificationNodes appends the node and its descendants, parentIds
+// holds the identifiers of the ancestors of the node.
+func appendClassificationNodes(nodes *[]ClassificationNodeModel, node *workitemtracking.WorkItemClassificationNode, parentIds []string) {
+	nodeIds := append(append([]string{}, parentIds...), node.Identifier.String())
+
+	nodeModel := ClassificationNodeModel{
+		Id:          types.StringValue(node.Identifier.String()),
+		NodeId:      types.Int64Value(int64(*node.Id)),
+		Name:        types.StringPointerValue(node.Name),
+		Path:        types.StringValue(services.ClassificationNodePath(node)),
+		ParentId:    types.StringValue(""),
+		NodeIds:     []types.String{},
+		Token:       types.StringValue(services.ClassificationNodeToken(nodeIds)),
+		HasChildren: types.BoolValue(node.HasChildren != nil && *node.HasChildren),
+		StartDate:   types.StringValue(services.ClassificationNodeDate(node, "startDate")),
+		FinishDate:  types.StringValue(services.ClassificationNodeDate(node, "finishDate")),
+	}
+	if len(parentIds) > 0 {
+		nodeModel.ParentId = types.StringValue(parentIds[len(parentIds)-1])
+	}
+	for _, nodeId := range nodeIds {
+		nodeModel.NodeIds = append(nodeModel.NodeIds, types.StringValue(nodeId))
+	}
+	*nodes = append(*nodes, nodeModel)
+
+	if node.Children != nil {
+		for i := range *node.Children {
+			appendClassificationNodes(nodes, &(*node.Children)[i], nodeIds)
+		}
+	}
+}
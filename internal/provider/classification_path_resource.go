@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtracking"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ClassificationPathResource{}
+var _ resource.ResourceWithImportState = &ClassificationPathResource{}
+
+func NewAreaPathResource() resource.Resource {
+	return &ClassificationPathResource{
+		name:           "area",
+		description:    "Azdo Area path resource",
+		structureGroup: workitemtracking.TreeStructureGroupValues.Areas,
+	}
+}
+
+// ClassificationPathResource defines the resource implementation of the area
+// paths, the iteration paths extend it with their dates.
+type ClassificationPathResource struct {
+	client *workitemtracking.ClientImpl
+	// name is the name of the classification, area or iteration.
+	name           string
+	description    string
+	structureGroup workitemtracking.TreeStructureGroup
+}
+
+// ClassificationPathResourceModel describes the resource data model.
+type ClassificationPathResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	NodeId     types.Int64  `tfsdk:"node_id"`
+	ProjectId  types.String `tfsdk:"project_id"`
+	Name       types.String `tfsdk:"name"`
+	ParentPath types.String `tfsdk:"parent_path"`
+	Path       types.String `tfsdk:"path"`
+}
+
+func (r *ClassificationPathResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.name + "_path"
+}
+
+func (r *ClassificationPathResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: r.schemaDescription(),
+		Attributes:          r.attributes(),
+	}
+}
+
+func (r *ClassificationPathResource) schemaDescription() string {
+	return fmt.Sprintf("%s. Nested paths are created by setting `parent_path` to the `path` of another %s path, changing `name` or `parent_path` renames or moves the path with its children. Existing paths are imported as `<project ID>/<node ID>`", r.description, r.name)
+}
+
+func (r *ClassificationPathResource) attributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("The identifier of the %s node, as used in the security tokens", r.name),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"node_id": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("The integer ID of the %s node", r.name),
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"project_id": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The ID of the project",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: fmt.Sprintf("The name of the %s path", r.name),
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.RegexMatches(regexp.MustCompile(`^[^\\/]+$`), "must not contain \\ or /"),
+			},
+		},
+		"parent_path": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("The path of the parent %s, for example `Project\\Team`. The first segment is the root node of the project, which is the parent when not set", r.name),
+		},
+		"path": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("The path of the %s, for example `Project\\Team\\Sub`", r.name),
+			PlanModifiers: []planmodifier.String{
+				useStateForUnknownUnlessChanged(path.Root("name"), path.Root("parent_path")),
+			},
+		},
+	}
+}
+
+func (r *ClassificationPathResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.WorkItemTrackingClient
+}
+
+func (r *ClassificationPathResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClassificationPathResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.create(ctx, &data, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClassificationPathResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClassificationPathResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node, err := r.read(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	if node == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClassificationPathResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ClassificationPathResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.update(ctx, &data, &state, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClassificationPathResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ClassificationPathResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.delete(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+}
+
+func (r *ClassificationPathResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Paths are imported as <project ID>/<node ID>.
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" {
+		resp.Diagnostics.AddError("Error", fmt.Sprintf("invalid import ID %s, expected <project ID>/<node ID>", req.ID))
+		return
+	}
+	nodeId, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", fmt.Sprintf("invalid import ID %s, expected <project ID>/<node ID>", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node_id"), nodeId)...)
+}
+
+// create creates the node below the parent path, the root node of the project
+// when the parent path is not set.
+func (r *ClassificationPathResource) create(ctx context.Context, data *ClassificationPathResourceModel, attributes *map[string]interface{}) (*workitemtracking.WorkItemClassificationNode, error) {
+	classificationService := services.NewClassificationService(r.client)
+
+	name := data.Name.ValueString()
+	node, err := classificationService.CreateClassificationNode(ctx, data.ProjectId.ValueString(), r.structureGroup, data.ParentPath.ValueString(), &workitemtracking.WorkItemClassificationNode{
+		Name:       &name,
+		Attributes: attributes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data.setNode(node)
+	return node, nil
+}
+
+// read refreshes the model from the node, the node is nil when it no longer exists.
+func (r *ClassificationPathResource) read(ctx context.Context, data *ClassificationPathResourceModel) (*workitemtracking.WorkItemClassificationNode, error) {
+	classificationService := services.NewClassificationService(r.client)
+
+	node, err := classificationService.GetClassificationNodeById(ctx, data.ProjectId.ValueString(), int(data.NodeId.ValueInt64()))
+	if err != nil {
+		return nil, err
+	}
+	if node == nil {
+		tflog.Info(ctx, fmt.Sprintf("%s path %d no longer exists, removing it from state", r.structureGroup, data.NodeId.ValueInt64()))
+		return nil, nil
+	}
+
+	data.setNode(node)
+	return node, nil
+}
+
+// update moves the node when its parent path changed, below the root node of
+// the project when the parent path is no longer set, then updates its name and
+// attributes.
+func (r *ClassificationPathResource) update(ctx context.Context, data *ClassificationPathResourceModel, state *ClassificationPathResourceModel, attributes *map[string]interface{}) (*workitemtracking.WorkItemClassificationNode, error) {
+	classificationService := services.NewClassificationService(r.client)
+	projectId := data.ProjectId.ValueString()
+	nodeId := int(data.NodeId.ValueInt64())
+
+	if !sameClassificationPath(data.ParentPath.ValueString(), state.ParentPath.ValueString()) {
+		err := classificationService.MoveClassificationNode(ctx, projectId, r.structureGroup, data.ParentPath.ValueString(), nodeId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The node is updated by its path, which changes when it is moved.
+	current, err := classificationService.GetClassificationNodeById(ctx, projectId, nodeId)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, fmt.Errorf("the %s path %d no longer exists", r.name, nodeId)
+	}
+
+	name := data.Name.ValueString()
+	node, err := classificationService.UpdateClassificationNode(ctx, projectId, r.structureGroup, services.ClassificationNodePath(current), &workitemtracking.WorkItemClassificationNode{
+		Name:       &name,
+		Attributes: attributes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data.setNode(node)
+	return node, nil
+}
+
+// delete deletes the node and moves its work items to the parent node.
+func (r *ClassificationPathResource) delete(ctx context.Context, data *ClassificationPathResourceModel) error {
+	classificationService := services.NewClassificationService(r.client)
+	projectId := data.ProjectId.ValueString()
+
+	parent, err := classificationService.GetClassificationNode(ctx, projectId, r.structureGroup, data.ParentPath.ValueString(), 0)
+	if services.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	err = classificationService.DeleteClassificationNode(ctx, projectId, r.structureGroup, data.Path.ValueString(), *parent.Id)
+	if err != nil && !services.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (m *ClassificationPathResourceModel) setNode(node *workitemtracking.WorkItemClassificationNode) {
+	m.Id = types.StringValue(node.Identifier.String())
+	m.NodeId = types.Int64Value(int64(*node.Id))
+	m.Name = types.StringPointerValue(node.Name)
+
+	nodePath := services.ClassificationNodePath(node)
+	m.Path = types.StringValue(nodePath)
+
+	// A configured parent path written differently is kept as configured, an
+	// unset parent path is kept as long as the parent is the root node.
+	parentPath := ""
+	if index := strings.LastIndex(nodePath, "\\"); index >= 0 {
+		parentPath = nodePath[:index]
+	}
+	if m.ParentPath.IsNull() && !strings.Contains(parentPath, "\\") {
+		return
+	}
+	if m.ParentPath.IsNull() || !sameClassificationPath(m.ParentPath.ValueString(), parentPath) {
+		m.ParentPath = types.StringValue(parentPath)
+	}
+}
+
+// sameClassificationPath compares two paths ignoring case and separators.
+func sameClassificationPath(a string, b string) bool {
+	return strings.EqualFold(strings.Join(services.SplitClassificationPath(a), "\\"), strings.Join(services.SplitClassificationPath(b), "\\"))
+}
@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-azdo/services"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtracking"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ClassificationPermissionsResource{}
+
+func NewAreaPermissionsResource() resource.Resource {
+	return &ClassificationPermissionsResource{
+		name:           "area",
+		description:    "Azdo Area path permissions resource",
+		structureGroup: workitemtracking.TreeStructureGroupValues.Areas,
+		namespaceId:    services.AreaNamespaceId,
+		examples:       "`GENERIC_READ`, `WORK_ITEM_WRITE` or `CREATE_CHILDREN`",
+	}
+}
+
+func NewIterationPermissionsResource() resource.Resource {
+	return &ClassificationPermissionsResource{
+		name:           "iteration",
+		description:    "Azdo Iteration path permissions resource",
+		structureGroup: workitemtracking.TreeStructureGroupValues.Iterations,
+		namespaceId:    services.IterationNamespaceId,
+		examples:       "`GENERIC_READ`, `GENERIC_WRITE` or `CREATE_CHILDREN`",
+	}
+}
+
+// ClassificationPermissionsResource defines the resource implementation shared
+// by the area and iteration permissions resources.
+type ClassificationPermissionsResource struct {
+	client                 *security.ClientImpl
+	workItemTrackingClient *workitemtracking.ClientImpl
+	// name is the name of the classification, area or iteration.
+	name           string
+	description    string
+	structureGroup workitemtracking.TreeStructureGroup
+	namespaceId    uuid.UUID
+	// examples lists some permission names of the security namespace.
+	examples string
+}
+
+// ClassificationPermissionsResourceModel describes the resource data model.
+type ClassificationPermissionsResourceModel struct {
+	Id          types.String            `tfsdk:"id"`
+	ProjectId   types.String            `tfsdk:"project_id"`
+	Path        types.String            `tfsdk:"path"`
+	Token       types.String            `tfsdk:"token"`
+	Principal   types.String            `tfsdk:"principal"`
+	Permissions map[string]types.String `tfsdk:"permissions"`
+}
+
+func (r *ClassificationPermissionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.name + "_permissions"
+}
+
+func (r *ClassificationPermissionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: r.description,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The security token and principal",
+				PlanModifiers: []planmodifier.String{
+					useStateForUnknownUnlessChanged(path.Root("path")),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the project",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: fmt.Sprintf("The %s path starting with the name of the project, for example `Project\\Team\\Sub`. The permissions are moved to the node of the new path when it changes", r.name),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The security token, the chain of the identifiers of the nodes of the path",
+				PlanModifiers: []planmodifier.String{
+					useStateForUnknownUnlessChanged(path.Root("path")),
+				},
+			},
+			"principal": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The descriptor of the identity to set the permissions for, see `azdo_identity`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: fmt.Sprintf("Map of permission names, for example %s, to `Allow`, `Deny` or `NotSet`", r.examples),
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf(services.PermissionValues...)),
+				},
+			},
+		},
+	}
+}
+
+func (r *ClassificationPermissionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.SecurityClient
+	r.workItemTrackingClient = clients.WorkItemTrackingClient
+}
+
+func (r *ClassificationPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClassificationPermissionsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	token, err := r.resolveToken(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	err = securityService.SetPermissions(ctx, r.namespaceId, token, data.Principal.ValueString(), permissionsFromModel(data.Permissions))
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Token = types.StringValue(token)
+	data.Id = types.StringValue(permissionsId(token, data.Principal.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClassificationPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClassificationPermissionsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	// The token is resolved again, the path resolves to other nodes once they are renamed or moved.
+	// The prior token is kept in state, so that Update moves the permissions from the prior node
+	// when those of the node the path resolves to differ.
+	token, err := r.resolveToken(ctx, &data)
+	if services.IsNotFound(err) {
+		tflog.Info(ctx, fmt.Sprintf("The %s path %s no longer exists, removing it from state", r.name, data.Path.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	permissions, err := securityService.GetPermissions(ctx, r.namespaceId, token, data.Principal.ValueString(), permissionNames(data.Permissions))
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Permissions = permissionsToModel(permissions)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClassificationPermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ClassificationPermissionsResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	token, err := r.resolveToken(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	permissions := permissionsFromPlan(data.Permissions, state.Permissions)
+	if token != state.Token.ValueString() {
+		// The path resolves to another node, the permissions are moved from the prior node.
+		err = securityService.ResetPermissions(ctx, r.namespaceId, state.Token.ValueString(), state.Principal.ValueString(), permissionNames(state.Permissions))
+		if err != nil {
+			resp.Diagnostics.AddError("Error", err.Error())
+			return
+		}
+		permissions = permissionsFromModel(data.Permissions)
+	}
+
+	err = securityService.SetPermissions(ctx, r.namespaceId, token, data.Principal.ValueString(), permissions)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Token = types.StringValue(token)
+	data.Id = types.StringValue(permissionsId(token, data.Principal.ValueString()))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClassificationPermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ClassificationPermissionsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	err := securityService.ResetPermissions(ctx, r.namespaceId, data.Token.ValueString(), data.Principal.ValueString(), permissionNames(data.Permissions))
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+}
+
+// resolveToken builds the security token from the identifiers of the nodes of the path.
+func (r *ClassificationPermissionsResource) resolveToken(ctx context.Context, data *ClassificationPermissionsResourceModel) (string, error) {
+	classificationService := services.NewClassificationService(r.workItemTrackingClient)
+
+	nodeIds, err := classificationService.GetClassificationNodeIds(ctx, data.ProjectId.ValueString(), r.structureGroup, data.Path.ValueString())
+	if err != nil {
+		return "", err
+	}
+	return services.ClassificationNodeToken(nodeIds), nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ClientConfigDataSource{}
+
+func NewClientConfigDataSource() datasource.DataSource {
+	log.Println("NewClientConfigDataSource")
+	return &ClientConfigDataSource{}
+}
+
+// ClientConfigDataSource defines the data source implementation.
+type ClientConfigDataSource struct {
+	clients *AzdoClients
+}
+
+// ClientConfigDataSourceModel describes the data source data model.
+type ClientConfigDataSourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	DisplayName       types.String `tfsdk:"display_name"`
+	AccountName       types.String `tfsdk:"account_name"`
+	SubjectDescriptor types.String `tfsdk:"subject_descriptor"`
+	Descriptor        types.String `tfsdk:"descriptor"`
+	ServiceUrl        types.String `tfsdk:"org_service_url"`
+}
+
+func (d *ClientConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_config"
+}
+
+func (d *ClientConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Client config of the authenticated identity",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the authenticated identity",
+			},
+			"display_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The display name of the authenticated identity",
+			},
+			"account_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The account name of the authenticated identity",
+			},
+			"subject_descriptor": schema.StringAttribute{
+				Computed:    true,
+				Description: "The subject descriptor of the authenticated identity",
+			},
+			"descriptor": schema.StringAttribute{
+				Computed:    true,
+				Description: "The descriptor of the authenticated identity",
+			},
+			"org_service_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The normalized url of the Azure DevOps instance the provider is connected to",
+			},
+		},
+	}
+}
+
+func (d *ClientConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	log.Println("Configure ClientConfigDataSource")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clients = clients
+}
+
+func (d *ClientConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClientConfigDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityService := services.NewIdentityService(d.clients.IdentityClient)
+
+	var self, err = identityService.GetSelf(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// The self endpoint does not return descriptors, so read the full identity.
+	selfIdentity, err := identityService.GetIdentityById(ctx, self.Id.String())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	data = ClientConfigDataSourceModel{
+		Id:                types.StringValue(self.Id.String()),
+		DisplayName:       types.StringValue(services.IdentityDisplayName(selfIdentity)),
+		AccountName:       types.StringPointerValue(self.AccountName),
+		SubjectDescriptor: types.StringPointerValue(selfIdentity.SubjectDescriptor),
+		Descriptor:        types.StringPointerValue(selfIdentity.Descriptor),
+		ServiceUrl:        types.StringValue(d.clients.ServiceUrl),
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
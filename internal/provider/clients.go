@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/operations"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/pipelineschecks"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/work"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtracking"
+)
+
+// AzdoClients holds the Azure DevOps API clients shared by the data sources
+// and resources of the provider.
+type AzdoClients struct {
+	// ServiceUrl is the normalized url of the collection the clients connect to.
+	ServiceUrl             string
+	IdentityClient         *identity.ClientImpl
+	SecurityClient         *security.ClientImpl
+	PolicyClient           *policy.ClientImpl
+	WorkItemTrackingClient *workitemtracking.ClientImpl
+	SecurityRolesClient    *azuredevops.Client
+	ChecksClient           *pipelineschecks.ClientImpl
+	CoreClient             *core.ClientImpl
+	OperationsClient       *operations.ClientImpl
+	GitClient              *git.ClientImpl
+	WorkClient             *work.ClientImpl
+}
+
+func NewAzdoClients(ctx context.Context, connection *azuredevops.Connection) (*AzdoClients, error) {
+	// Create a client to interact with the Identity area
+	identityClient, err := identity.NewClient(ctx, connection)
+	if err != nil {
+		return nil, err
+	}
+	identityClientImpl, ok := identityClient.(*identity.ClientImpl)
+	if !ok {
+		return nil, fmt.Errorf("unexpected identity client type %T", identityClient)
+	}
+
+	// Create a client to interact with the Security area
+	securityClient := security.NewClient(ctx, connection)
+	securityClientImpl, ok := securityClient.(*security.ClientImpl)
+	if !ok {
+		return nil, fmt.Errorf("unexpected security client type %T", securityClient)
+	}
+
+	// Create a client to interact with the Policy area
+	policyClient, err := policy.NewClient(ctx, connection)
+	if err != nil {
+		return nil, err
+	}
+	policyClientImpl, ok := policyClient.(*policy.ClientImpl)
+	if !ok {
+		return nil, fmt.Errorf("unexpected policy client type %T", policyClient)
+	}
+
+	// Create a client to interact with the Work Item Tracking area
+	workItemTrackingClient, err := workitemtracking.NewClient(ctx, connection)
+	if err != nil {
+		return nil, err
+	}
+	workItemTrackingClientImpl, ok := workItemTrackingClient.(*workitemtracking.ClientImpl)
+	if !ok {
+		return nil, fmt.Errorf("unexpected work item tracking client type %T", workItemTrackingClient)
+	}
+
+	// Create a client to interact with the Security Roles area, the go api has no typed client for it
+	securityRolesClient := connection.GetClientByUrl(connection.BaseUrl)
+
+	// Create a client to interact with the Pipelines Checks area
+	checksClient, err := pipelineschecks.NewClient(ctx, connection)
+	if err != nil {
+		return nil, err
+	}
+	checksClientImpl, ok := checksClient.(*pipelineschecks.ClientImpl)
+	if !ok {
+		return nil, fmt.Errorf("unexpected checks client type %T", checksClient)
+	}
+
+	// Create a client to interact with the Core area
+	coreClient, err := core.NewClient(ctx, connection)
+	if err != nil {
+		return nil, err
+	}
+	coreClientImpl, ok := coreClient.(*core.ClientImpl)
+	if !ok {
+		return nil, fmt.Errorf("unexpected core client type %T", coreClient)
+	}
+
+	// Create a client to interact with the Operations area
+	operationsClient := operations.NewClient(ctx, connection)
+	operationsClientImpl, ok := operationsClient.(*operations.ClientImpl)
+	if !ok {
+		return nil, fmt.Errorf("unexpected operations client type %T", operationsClient)
+	}
+
+	// Create a client to interact with the Git area
+	gitClient, err := git.NewClient(ctx, connection)
+	if err != nil {
+		return nil, err
+	}
+	gitClientImpl, ok := gitClient.(*git.ClientImpl)
+	if !ok {
+		return nil, fmt.Errorf("unexpected git client type %T", gitClient)
+	}
+
+	// Create a client to interact with the Work area
+	workClient, err := work.NewClient(ctx, connection)
+	if err != nil {
+		return nil, err
+	}
+	workClientImpl, ok := workClient.(*work.ClientImpl)
+	if !ok {
+		return nil, fmt.Errorf("unexpected work client type %T", workClient)
+	}
+
+	return &AzdoClients{
+		ServiceUrl:             connection.BaseUrl,
+		IdentityClient:         identityClientImpl,
+		SecurityClient:         securityClientImpl,
+		PolicyClient:           policyClientImpl,
+		WorkItemTrackingClient: workItemTrackingClientImpl,
+		SecurityRolesClient:    securityRolesClient,
+		ChecksClient:           checksClientImpl,
+		CoreClient:             coreClientImpl,
+		OperationsClient:       operationsClientImpl,
+		GitClient:              gitClientImpl,
+		WorkClient:             workClientImpl,
+	}, nil
+}
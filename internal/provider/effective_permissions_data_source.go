@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &EffectivePermissionsDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &EffectivePermissionsDataSource{}
+
+func NewEffectivePermissionsDataSource() datasource.DataSource {
+	log.Println("NewEffectivePermissionsDataSource")
+	return &EffectivePermissionsDataSource{}
+}
+
+// EffectivePermissionsDataSource defines the data source implementation.
+type EffectivePermissionsDataSource struct {
+	client *security.ClientImpl
+}
+
+// EffectivePermissionsDataSourceModel describes the data source data model.
+type EffectivePermissionsDataSourceModel struct {
+	NamespaceId   types.String               `tfsdk:"namespace_id"`
+	NamespaceName types.String               `tfsdk:"namespace_name"`
+	Token         types.String               `tfsdk:"token"`
+	Principal     types.String               `tfsdk:"principal"`
+	Permissions   []EffectivePermissionModel `tfsdk:"permissions"`
+}
+
+type EffectivePermissionModel struct {
+	Name      types.String `tfsdk:"name"`
+	Bit       types.Int64  `tfsdk:"bit"`
+	Allowed   types.Bool   `tfsdk:"allowed"`
+	Effective types.String `tfsdk:"effective"`
+	Inherited types.Bool   `tfsdk:"inherited"`
+}
+
+func (d *EffectivePermissionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_effective_permissions"
+}
+
+func (d *EffectivePermissionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Effective permissions. Evaluates the permissions of a principal on a token including the permissions inherited from parent tokens and groups",
+		Attributes: map[string]schema.Attribute{
+			"namespace_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the security namespace",
+			},
+			"namespace_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the security namespace, for example Git Repositories, Build or CSS",
+			},
+			"token": schema.StringAttribute{
+				Required:    true,
+				Description: "The security token to evaluate the permissions on",
+			},
+			"principal": schema.StringAttribute{
+				Optional:    true,
+				Description: "The descriptor of the identity to evaluate the permissions of, the permissions of the authenticated identity are evaluated when not set",
+			},
+			"permissions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The effective permissions for each action of the security namespace",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the action",
+						},
+						"bit": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The permission bit of the action",
+						},
+						"allowed": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the action is effectively allowed",
+						},
+						"effective": schema.StringAttribute{
+							Computed:    true,
+							Description: "The effective permission (Allow, Deny or NotSet), only set when a principal is given",
+						},
+						"inherited": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the effective permission comes from a parent token or a group instead of the entry of the principal on the token, only set when a principal is given",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EffectivePermissionsDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("namespace_id"),
+			path.MatchRoot("namespace_name"),
+		),
+	}
+}
+
+func (d *EffectivePermissionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	log.Println("Configure EffectivePermissionsDataSource")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.SecurityClient
+}
+
+func (d *EffectivePermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EffectivePermissionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(d.client)
+
+	namespace, err := getSecurityNamespace(ctx, securityService, data.NamespaceId, data.NamespaceName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	// Fill in the namespace attribute that was not configured.
+	if data.NamespaceId.IsNull() {
+		data.NamespaceId = types.StringValue(namespace.NamespaceId.String())
+	}
+	if data.NamespaceName.IsNull() {
+		data.NamespaceName = types.StringPointerValue(namespace.Name)
+	}
+
+	var ace *security.AccessControlEntry
+	if !data.Principal.IsNull() {
+		ace, err = securityService.GetEffectiveAccessControlEntry(ctx, *namespace.NamespaceId, data.Token.ValueString(), data.Principal.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error", err.Error())
+			return
+		}
+	}
+
+	data.Permissions = []EffectivePermissionModel{}
+	if namespace.Actions != nil {
+		for _, action := range *namespace.Actions {
+			if action.Name == nil || action.Bit == nil {
+				continue
+			}
+			permission := EffectivePermissionModel{
+				Name:      types.StringValue(*action.Name),
+				Bit:       types.Int64Value(int64(*action.Bit)),
+				Effective: types.StringNull(),
+				Inherited: types.BoolNull(),
+			}
+
+			if ace != nil {
+				effective := effectivePermission(ace, *action.Bit)
+				permission.Allowed = types.BoolValue(effective == services.PermissionAllow)
+				permission.Effective = types.StringValue(effective)
+				explicit := (*ace.Allow | *ace.Deny) & *action.Bit
+				permission.Inherited = types.BoolValue(effective != services.PermissionNotSet && explicit == 0)
+			} else {
+				// Without a principal the permissions are evaluated for the caller,
+				// which only tells whether the action is allowed.
+				allowed, err := securityService.HasPermission(ctx, *namespace.NamespaceId, data.Token.ValueString(), *action.Bit)
+				if err != nil {
+					resp.Diagnostics.AddError("Error", err.Error())
+					return
+				}
+				permission.Allowed = types.BoolValue(allowed)
+			}
+
+			data.Permissions = append(data.Permissions, permission)
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func effectivePermission(ace *security.AccessControlEntry, bit int) string {
+	extendedInfo := ace.ExtendedInfo
+	switch {
+	case extendedInfo.EffectiveDeny != nil && *extendedInfo.EffectiveDeny&bit != 0:
+		return services.PermissionDeny
+	case extendedInfo.EffectiveAllow != nil && *extendedInfo.EffectiveAllow&bit != 0:
+		return services.PermissionAllow
+	default:
+		return services.PermissionNotSet
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"terraform-provider-azdo/services"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/pipelineschecks"
+)
+
+// environmentCheckAttributes returns the attributes shared by the environment check resources.
+func environmentCheckAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the check configuration",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"project_id": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The ID of the project",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"environment_id": schema.Int64Attribute{
+			Required:            true,
+			MarkdownDescription: "The ID of the environment",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+// newEnvironmentCheck returns a check configuration of the given type on an environment.
+func newEnvironmentCheck(environmentId types.Int64, typeId uuid.UUID, typeName string, settings map[string]interface{}) *pipelineschecks.CheckConfiguration {
+	resourceType := services.EnvironmentResourceType
+	resourceId := strconv.FormatInt(environmentId.ValueInt64(), 10)
+	return &pipelineschecks.CheckConfiguration{
+		Type: &pipelineschecks.CheckType{
+			Id:   &typeId,
+			Name: &typeName,
+		},
+		Resource: &pipelineschecks.Resource{
+			Type: &resourceType,
+			Id:   &resourceId,
+		},
+		Settings: settings,
+	}
+}
+
+// environmentCheckId parses the ID of a check configuration stored in state.
+func environmentCheckId(id types.String) (int, error) {
+	configurationId, err := strconv.Atoi(id.ValueString())
+	if err != nil {
+		return 0, fmt.Errorf("invalid check configuration ID %s: %s", id.ValueString(), err)
+	}
+	return configurationId, nil
+}
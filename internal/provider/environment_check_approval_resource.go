@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/pipelineschecks"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EnvironmentCheckApprovalResource{}
+
+func NewEnvironmentCheckApprovalResource() resource.Resource {
+	return &EnvironmentCheckApprovalResource{}
+}
+
+// EnvironmentCheckApprovalResource defines the resource implementation.
+type EnvironmentCheckApprovalResource struct {
+	client         *pipelineschecks.ClientImpl
+	identityClient *identity.ClientImpl
+}
+
+// EnvironmentCheckApprovalResourceModel describes the resource data model.
+type EnvironmentCheckApprovalResourceModel struct {
+	Id                  types.String   `tfsdk:"id"`
+	ProjectId           types.String   `tfsdk:"project_id"`
+	EnvironmentId       types.Int64    `tfsdk:"environment_id"`
+	Approvers           []types.String `tfsdk:"approvers"`
+	MinimumApprovers    types.Int64    `tfsdk:"minimum_approvers"`
+	Instructions        types.String   `tfsdk:"instructions"`
+	RequesterCanApprove types.Bool     `tfsdk:"requester_can_approve"`
+	Sequential          types.Bool     `tfsdk:"sequential"`
+}
+
+func (r *EnvironmentCheckApprovalResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_check_approval"
+}
+
+func (r *EnvironmentCheckApprovalResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := environmentCheckAttributes()
+	attributes["approvers"] = schema.ListAttribute{
+		ElementType:         types.StringType,
+		Required:            true,
+		MarkdownDescription: "The users and groups that approve deployments to the environment, given as IDs, descriptors, subject descriptors or names like `[Project]\\Release Approvers`",
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+	}
+	attributes["minimum_approvers"] = schema.Int64Attribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             int64default.StaticInt64(0),
+		MarkdownDescription: "The minimum number of approvers that must approve, all approvers must approve when 0",
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+	}
+	attributes["instructions"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(""),
+		MarkdownDescription: "The instructions shown to the approvers",
+	}
+	attributes["requester_can_approve"] = schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Whether the user that queued the run can approve it",
+	}
+	attributes["sequential"] = schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Whether the approvers approve in the order of `approvers` instead of any order",
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Environment approval check resource, requires deployments to the environment to be approved",
+		Attributes:          attributes,
+	}
+}
+
+func (r *EnvironmentCheckApprovalResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.ChecksClient
+	r.identityClient = clients.IdentityClient
+}
+
+func (r *EnvironmentCheckApprovalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EnvironmentCheckApprovalResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.settings(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	checkService := services.NewCheckService(r.client)
+
+	configuration, err := checkService.CreateCheckConfiguration(ctx, data.ProjectId.ValueString(), newEnvironmentCheck(data.EnvironmentId, services.ApprovalCheckTypeId, "Approval", settings))
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Id = types.StringValue(strconv.Itoa(*configuration.Id))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentCheckApprovalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EnvironmentCheckApprovalResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configurationId, err := environmentCheckId(data.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	checkService := services.NewCheckService(r.client)
+
+	configuration, err := checkService.GetCheckConfiguration(ctx, data.ProjectId.ValueString(), configurationId)
+	if services.IsNotFound(err) {
+		tflog.Info(ctx, fmt.Sprintf("Check configuration %d no longer exists, removing it from state", configurationId))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	if settings, ok := configuration.Settings.(map[string]interface{}); ok {
+		data.MinimumApprovers = documentInt64(settings, "minRequiredApprovers")
+		data.Instructions = documentString(settings, "instructions")
+		if data.Instructions.IsNull() {
+			data.Instructions = types.StringValue("")
+		}
+		data.RequesterCanApprove = types.BoolValue(!documentBool(settings, "requesterCannotBeApprover").ValueBool())
+		data.Sequential = types.BoolValue(documentString(settings, "executionOrder").ValueString() == "inSequence")
+
+		// Keep the configured approvers when they still resolve to the approvers of the check.
+		approverIds := approverIdsFromDocument(settings)
+		configuredIds, err := r.resolveApproverIds(ctx, data.Approvers)
+		if err != nil || !sameApproverIds(configuredIds, approverIds, data.Sequential.ValueBool()) {
+			data.Approvers = make([]types.String, 0, len(approverIds))
+			for _, id := range approverIds {
+				data.Approvers = append(data.Approvers, types.StringValue(id))
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentCheckApprovalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data EnvironmentCheckApprovalResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configurationId, err := environmentCheckId(data.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	settings, err := r.settings(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	checkService := services.NewCheckService(r.client)
+
+	configuration := newEnvironmentCheck(data.EnvironmentId, services.ApprovalCheckTypeId, "Approval", settings)
+	configuration.Id = &configurationId
+	_, err = checkService.UpdateCheckConfiguration(ctx, data.ProjectId.ValueString(), configurationId, configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentCheckApprovalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EnvironmentCheckApprovalResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configurationId, err := environmentCheckId(data.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	checkService := services.NewCheckService(r.client)
+
+	err = checkService.DeleteCheckConfiguration(ctx, data.ProjectId.ValueString(), configurationId)
+	if err != nil && !services.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+}
+
+// settings builds the settings document of the check, resolving the approvers to identity IDs.
+func (r *EnvironmentCheckApprovalResource) settings(ctx context.Context, data *EnvironmentCheckApprovalResourceModel) (map[string]interface{}, error) {
+	approverIds, err := r.resolveApproverIds(ctx, data.Approvers)
+	if err != nil {
+		return nil, err
+	}
+
+	approvers := make([]interface{}, 0, len(approverIds))
+	for _, identityId := range approverIds {
+		approvers = append(approvers, map[string]interface{}{"id": identityId})
+	}
+
+	executionOrder := "anyOrder"
+	if data.Sequential.ValueBool() {
+		executionOrder = "inSequence"
+	}
+
+	return map[string]interface{}{
+		"approvers":                 approvers,
+		"minRequiredApprovers":      data.MinimumApprovers.ValueInt64(),
+		"instructions":              data.Instructions.ValueString(),
+		"requesterCannotBeApprover": !data.RequesterCanApprove.ValueBool(),
+		"executionOrder":            executionOrder,
+	}, nil
+}
+
+// resolveApproverIds resolves the configured approvers to identity IDs.
+func (r *EnvironmentCheckApprovalResource) resolveApproverIds(ctx context.Context, approvers []types.String) ([]string, error) {
+	identityService := services.NewIdentityService(r.identityClient)
+
+	ids := make([]string, 0, len(approvers))
+	for _, approver := range approvers {
+		id, err := identityService.ResolveIdentityId(ctx, approver.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// approverIdsFromDocument returns the identity IDs of the approvers in the settings of the check.
+func approverIdsFromDocument(document map[string]interface{}) []string {
+	var ids []string
+	approvers, _ := document["approvers"].([]interface{})
+	for _, approver := range approvers {
+		approver, ok := approver.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if id, ok := approver["id"].(string); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// sameApproverIds reports whether both lists hold the same identity IDs, in
+// the same order when the approvers approve in sequence.
+func sameApproverIds(a []string, b []string, sequential bool) bool {
+	if !sequential {
+		return sameIdentityIds(a, b)
+	}
+	return slices.EqualFunc(a, b, strings.EqualFold)
+}
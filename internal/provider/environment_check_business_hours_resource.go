@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/pipelineschecks"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EnvironmentCheckBusinessHoursResource{}
+
+// Days accepted by the business hours check.
+var businessDays = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+
+// Times of the business hours check, for example 08:30.
+var businessHoursTimeRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+func NewEnvironmentCheckBusinessHoursResource() resource.Resource {
+	return &EnvironmentCheckBusinessHoursResource{}
+}
+
+// EnvironmentCheckBusinessHoursResource defines the resource implementation.
+type EnvironmentCheckBusinessHoursResource struct {
+	client *pipelineschecks.ClientImpl
+}
+
+// EnvironmentCheckBusinessHoursResourceModel describes the resource data model.
+type EnvironmentCheckBusinessHoursResourceModel struct {
+	Id            types.String   `tfsdk:"id"`
+	ProjectId     types.String   `tfsdk:"project_id"`
+	EnvironmentId types.Int64    `tfsdk:"environment_id"`
+	DisplayName   types.String   `tfsdk:"display_name"`
+	TimeZone      types.String   `tfsdk:"time_zone"`
+	StartTime     types.String   `tfsdk:"start_time"`
+	EndTime       types.String   `tfsdk:"end_time"`
+	Days          []types.String `tfsdk:"days"`
+}
+
+func (r *EnvironmentCheckBusinessHoursResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_check_business_hours"
+}
+
+func (r *EnvironmentCheckBusinessHoursResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := environmentCheckAttributes()
+	attributes["display_name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("Business Hours"),
+		MarkdownDescription: "The name of the check shown in the runs",
+	}
+	attributes["time_zone"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The ID of the time zone of the business hours, for example `UTC` or `W. Europe Standard Time`",
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+	attributes["start_time"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The start of the business hours, for example `08:00`",
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(businessHoursTimeRegexp, "must be a time formatted as HH:MM"),
+		},
+	}
+	attributes["end_time"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The end of the business hours, for example `17:30`",
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(businessHoursTimeRegexp, "must be a time formatted as HH:MM"),
+		},
+	}
+	attributes["days"] = schema.SetAttribute{
+		ElementType:         types.StringType,
+		Required:            true,
+		MarkdownDescription: "The business days, for example `Monday` or `Friday`",
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(stringvalidator.OneOf(businessDays...)),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Environment business hours check resource, only allows deployments to the environment during business hours",
+		Attributes:          attributes,
+	}
+}
+
+func (r *EnvironmentCheckBusinessHoursResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.ChecksClient
+}
+
+func (r *EnvironmentCheckBusinessHoursResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EnvironmentCheckBusinessHoursResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkService := services.NewCheckService(r.client)
+
+	configuration, err := checkService.CreateCheckConfiguration(ctx, data.ProjectId.ValueString(), newEnvironmentCheck(data.EnvironmentId, services.TaskCheckTypeId, "Task Check", data.settings()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Id = types.StringValue(strconv.Itoa(*configuration.Id))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentCheckBusinessHoursResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EnvironmentCheckBusinessHoursResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configurationId, err := environmentCheckId(data.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	checkService := services.NewCheckService(r.client)
+
+	configuration, err := checkService.GetCheckConfiguration(ctx, data.ProjectId.ValueString(), configurationId)
+	if services.IsNotFound(err) {
+		tflog.Info(ctx, fmt.Sprintf("Check configuration %d no longer exists, removing it from state", configurationId))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	if settings, ok := configuration.Settings.(map[string]interface{}); ok {
+		if displayName := documentString(settings, "displayName"); !displayName.IsNull() {
+			data.DisplayName = displayName
+		}
+		if inputs, ok := settings["inputs"].(map[string]interface{}); ok {
+			data.TimeZone = documentString(inputs, "timeZone")
+			data.StartTime = documentString(inputs, "startTime")
+			data.EndTime = documentString(inputs, "endTime")
+			data.Days = []types.String{}
+			for _, day := range strings.Split(documentString(inputs, "businessDays").ValueString(), ",") {
+				if day = strings.TrimSpace(day); day != "" {
+					data.Days = append(data.Days, types.StringValue(day))
+				}
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentCheckBusinessHoursResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data EnvironmentCheckBusinessHoursResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configurationId, err := environmentCheckId(data.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	checkService := services.NewCheckService(r.client)
+
+	configuration := newEnvironmentCheck(data.EnvironmentId, services.TaskCheckTypeId, "Task Check", data.settings())
+	configuration.Id = &configurationId
+	_, err = checkService.UpdateCheckConfiguration(ctx, data.ProjectId.ValueString(), configurationId, configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentCheckBusinessHoursResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EnvironmentCheckBusinessHoursResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configurationId, err := environmentCheckId(data.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	checkService := services.NewCheckService(r.client)
+
+	err = checkService.DeleteCheckConfiguration(ctx, data.ProjectId.ValueString(), configurationId)
+	if err != nil && !services.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+}
+
+// settings builds the settings document of the task check evaluating the business hours.
+func (m *EnvironmentCheckBusinessHoursResourceModel) settings() map[string]interface{} {
+	return map[string]interface{}{
+		"definitionRef": map[string]interface{}{
+			"id":      services.BusinessHoursTaskId.String(),
+			"name":    "evaluatebusinesshours",
+			"version": "0.0.1",
+		},
+		"displayName": m.DisplayName.ValueString(),
+		"inputs": map[string]interface{}{
+			"businessDays": strings.Join(documentStringsFromList(m.Days), ","),
+			"timeZone":     m.TimeZone.ValueString(),
+			"startTime":    m.StartTime.ValueString(),
+			"endTime":      m.EndTime.ValueString(),
+		},
+		"retryInterval": 5,
+	}
+}
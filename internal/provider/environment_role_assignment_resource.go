@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewEnvironmentRoleAssignmentResource() resource.Resource {
+	return &RoleAssignmentResource{
+		name:            "environment",
+		description:     "Azdo Environment role assignment resource",
+		roles:           environmentRoles,
+		roleDescription: "The name of the role, `Reader`, `User` or `Administrator`",
+		resourceAttributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the project",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the environment",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+		importAttributes: []string{"project_id", "environment_id"},
+		scope: func(values map[string]attr.Value) (string, string) {
+			projectId := values["project_id"].(types.String)
+			return services.EnvironmentRoleScope, services.EnvironmentRoleResourceId(projectId.ValueString(), int64AttributeString(values["environment_id"]))
+		},
+	}
+}
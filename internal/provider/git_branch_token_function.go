@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &GitBranchTokenFunction{}
+
+func NewGitBranchTokenFunction() function.Function {
+	return &GitBranchTokenFunction{}
+}
+
+// GitBranchTokenFunction defines the function implementation.
+type GitBranchTokenFunction struct{}
+
+func (f *GitBranchTokenFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "git_branch_token"
+}
+
+func (f *GitBranchTokenFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Security token of a Git branch",
+		MarkdownDescription: "Returns the security token of a branch of a Git repository in the `Git Repositories` security namespace. The branch name is hex encoded the way Azure DevOps expects it",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "project_id",
+				MarkdownDescription: "The ID of the project",
+			},
+			function.StringParameter{
+				Name:                "repository_id",
+				MarkdownDescription: "The ID of the repository",
+			},
+			function.StringParameter{
+				Name:                "branch_name",
+				MarkdownDescription: "The name of the branch, for example `main` or `refs/heads/main`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *GitBranchTokenFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var projectId, repositoryId, branchName string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &projectId, &repositoryId, &branchName))
+	if resp.Error != nil {
+		return
+	}
+	if projectId == "" {
+		resp.Error = function.NewArgumentFuncError(0, "project_id must not be empty")
+		return
+	}
+	if repositoryId == "" {
+		resp.Error = function.NewArgumentFuncError(1, "repository_id must not be empty")
+		return
+	}
+	if branchName == "" {
+		resp.Error = function.NewArgumentFuncError(2, "branch_name must not be empty")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, services.GitRepositoryToken(projectId, repositoryId, branchName)))
+}
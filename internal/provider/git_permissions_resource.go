@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewGitPermissionsResource() resource.Resource {
+	return &PermissionsResource{
+		name:        "git",
+		description: "Azdo Git permissions resource",
+		namespaceId: services.GitRepositoriesNamespaceId,
+		examples:    "`GenericContribute` or `ForcePush`",
+		tokenAttributes: map[string]schema.Attribute{
+			"repository_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the repository, the permissions apply to all repositories of the project when not set",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the branch, for example `main` or `refs/heads/main`. Requires `repository_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("repository_id")),
+				},
+			},
+		},
+		token: func(projectId string, values map[string]attr.Value) string {
+			repositoryId := values["repository_id"].(types.String)
+			branchName := values["branch_name"].(types.String)
+			return services.GitRepositoryToken(projectId, repositoryId.ValueString(), branchName.ValueString())
+		},
+	}
+}
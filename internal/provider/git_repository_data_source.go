@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GitRepositoryDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &GitRepositoryDataSource{}
+
+func NewGitRepositoryDataSource() datasource.DataSource {
+	log.Println("NewGitRepositoryDataSource")
+	return &GitRepositoryDataSource{}
+}
+
+// GitRepositoryDataSource defines the data source implementation.
+type GitRepositoryDataSource struct {
+	client *git.ClientImpl
+}
+
+// GitRepositoryDataSourceModel describes the data source data model.
+type GitRepositoryDataSourceModel struct {
+	ProjectId         types.String `tfsdk:"project_id"`
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	DefaultBranch     types.String `tfsdk:"default_branch"`
+	Disabled          types.Bool   `tfsdk:"disabled"`
+	RemoteUrl         types.String `tfsdk:"remote_url"`
+	SshUrl            types.String `tfsdk:"ssh_url"`
+	WebUrl            types.String `tfsdk:"web_url"`
+	Token             types.String `tfsdk:"token"`
+	BranchTokenPrefix types.String `tfsdk:"branch_token_prefix"`
+}
+
+func (d *GitRepositoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_git_repository"
+}
+
+func (d *GitRepositoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Git repository",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the project",
+			},
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the repository",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the repository",
+			},
+			"default_branch": schema.StringAttribute{
+				Computed:    true,
+				Description: "The default branch of the repository, empty when the repository has no commits",
+			},
+			"disabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the repository is disabled",
+			},
+			"remote_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The https clone url of the repository",
+			},
+			"ssh_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ssh clone url of the repository",
+			},
+			"web_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The url of the repository in the web interface",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Description: "The security token of the repository in the Git Repositories namespace",
+			},
+			"branch_token_prefix": schema.StringAttribute{
+				Computed:    true,
+				Description: "The prefix of the security tokens of the branches of the repository, the encoded branch name is appended to it",
+			},
+		},
+	}
+}
+
+func (d *GitRepositoryDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("name"),
+			path.MatchRoot("id"),
+		),
+	}
+}
+
+func (d *GitRepositoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	log.Println("Configure GitRepositoryDataSource")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.GitClient
+}
+
+func (d *GitRepositoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GitRepositoryDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gitService := services.NewGitService(d.client)
+
+	// The repository API accepts both the ID and the name of the repository.
+	lookup := data.Id.ValueString()
+	if data.Id.IsNull() {
+		lookup = data.Name.ValueString()
+	}
+
+	repository, err := gitService.GetRepository(ctx, data.ProjectId.ValueString(), lookup)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Keep the configured lookup value as is.
+	if data.Id.IsNull() {
+		data.Id = types.StringValue(repository.Id.String())
+	}
+	if data.Name.IsNull() {
+		data.Name = types.StringPointerValue(repository.Name)
+	}
+	data.DefaultBranch = types.StringValue("")
+	if repository.DefaultBranch != nil {
+		data.DefaultBranch = types.StringValue(*repository.DefaultBranch)
+	}
+	data.Disabled = types.BoolValue(repository.IsDisabled != nil && *repository.IsDisabled)
+	data.RemoteUrl = types.StringPointerValue(repository.RemoteUrl)
+	data.SshUrl = types.StringPointerValue(repository.SshUrl)
+	data.WebUrl = types.StringPointerValue(repository.WebUrl)
+
+	// The tokens use the project ID of the repository, the configured project may be a name.
+	projectId := data.ProjectId.ValueString()
+	if repository.Project != nil && repository.Project.Id != nil {
+		projectId = repository.Project.Id.String()
+	}
+	token := services.GitRepositoryToken(projectId, repository.Id.String(), "")
+	data.Token = types.StringValue(token)
+	data.BranchTokenPrefix = types.StringValue(token + "/refs/heads/")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,388 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"terraform-provider-azdo/services"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GitRepositoryResource{}
+var _ resource.ResourceWithImportState = &GitRepositoryResource{}
+
+// The branch a repository is initialized with when no default branch is configured.
+const defaultInitialBranch = "refs/heads/main"
+
+func NewGitRepositoryResource() resource.Resource {
+	return &GitRepositoryResource{}
+}
+
+// GitRepositoryResource defines the resource implementation.
+type GitRepositoryResource struct {
+	client *git.ClientImpl
+}
+
+// GitRepositoryResourceModel describes the resource data model.
+type GitRepositoryResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	ProjectId          types.String `tfsdk:"project_id"`
+	Name               types.String `tfsdk:"name"`
+	DefaultBranch      types.String `tfsdk:"default_branch"`
+	Disabled           types.Bool   `tfsdk:"disabled"`
+	ParentRepositoryId types.String `tfsdk:"parent_repository_id"`
+	Initialize         types.Bool   `tfsdk:"initialize"`
+	ImportUrl          types.String `tfsdk:"import_url"`
+	RemoteUrl          types.String `tfsdk:"remote_url"`
+	SshUrl             types.String `tfsdk:"ssh_url"`
+	WebUrl             types.String `tfsdk:"web_url"`
+}
+
+func (r *GitRepositoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_git_repository"
+}
+
+func (r *GitRepositoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Git repository resource. A new repository is left empty, initialized with a first commit, imported from another git repository or forked from a parent repository. Existing repositories are imported as `<project ID>/<repository ID or name>`",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the repository",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the project",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the repository, changing it renames the repository",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"default_branch": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The default branch of the repository, for example `refs/heads/main`. The branch must exist unless the repository is initialized by the provider",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^refs/heads/.+`), "must start with refs/heads/"),
+				},
+			},
+			"disabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the repository is disabled. A disabled repository cannot be read or written by anyone",
+			},
+			"parent_repository_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the repository to fork, it may be in another project of the collection",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"initialize": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to initialize the repository with a commit adding an empty `README.md` to `default_branch`, or to `refs/heads/main` when not set",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"import_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The clone url of a git repository to import into the new repository, for example the url of a bare repository. The repository must be readable anonymously by the server",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("initialize"),
+						path.MatchRoot("parent_repository_id"),
+					),
+				},
+			},
+			"remote_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The https clone url of the repository",
+			},
+			"ssh_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ssh clone url of the repository",
+			},
+			"web_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The url of the repository in the web interface",
+			},
+		},
+	}
+}
+
+func (r *GitRepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.GitClient
+}
+
+func (r *GitRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GitRepositoryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gitService := services.NewGitService(r.client)
+	projectId := data.ProjectId.ValueString()
+
+	repository, err := gitService.CreateRepository(ctx, projectId, data.Name.ValueString(), data.ParentRepositoryId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Id = types.StringValue(repository.Id.String())
+
+	// Save the repository before it is initialized or imported, should a later
+	// step fail it is tainted and replaced on the next apply instead of orphaned.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), data.ProjectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), data.Id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Initialize.ValueBool() {
+		branch := defaultInitialBranch
+		if !data.DefaultBranch.IsUnknown() {
+			branch = data.DefaultBranch.ValueString()
+		}
+		err = gitService.InitializeRepository(ctx, projectId, *repository.Id, branch)
+	} else if data.ImportUrl.ValueString() != "" {
+		err = gitService.ImportRepository(ctx, projectId, *repository.Id, data.ImportUrl.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// The default branch is the first pushed or imported branch unless configured otherwise.
+	current, err := gitService.GetRepository(ctx, projectId, repository.Id.String())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	if !data.DefaultBranch.IsUnknown() && !data.DefaultBranch.Equal(types.StringPointerValue(current.DefaultBranch)) {
+		defaultBranch := data.DefaultBranch.ValueString()
+		err = gitService.UpdateRepository(ctx, projectId, *repository.Id, &git.GitRepository{DefaultBranch: &defaultBranch})
+		if err != nil {
+			resp.Diagnostics.AddError("Error", err.Error())
+			return
+		}
+	}
+
+	if data.Disabled.ValueBool() {
+		err = gitService.SetRepositoryDisabled(ctx, projectId, *repository.Id, true)
+		if err != nil {
+			resp.Diagnostics.AddError("Error", err.Error())
+			return
+		}
+	}
+
+	current, err = gitService.GetRepository(ctx, data.ProjectId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.setRepository(current)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitRepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GitRepositoryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gitService := services.NewGitService(r.client)
+
+	repository, err := gitService.GetRepository(ctx, data.ProjectId.ValueString(), data.Id.ValueString())
+	if services.IsNotFound(err) {
+		tflog.Info(ctx, fmt.Sprintf("Repository %s no longer exists, removing it from state", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.setRepository(repository)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state GitRepositoryResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repositoryId, err := uuid.Parse(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	gitService := services.NewGitService(r.client)
+	projectId := data.ProjectId.ValueString()
+
+	// A disabled repository cannot be updated, it is enabled first and disabled last.
+	if state.Disabled.ValueBool() && !data.Disabled.ValueBool() {
+		err = gitService.SetRepositoryDisabled(ctx, projectId, repositoryId, false)
+		if err != nil {
+			resp.Diagnostics.AddError("Error", err.Error())
+			return
+		}
+	}
+
+	update := git.GitRepository{}
+	if !data.Name.Equal(state.Name) {
+		name := data.Name.ValueString()
+		update.Name = &name
+	}
+	if !data.DefaultBranch.IsUnknown() && !data.DefaultBranch.Equal(state.DefaultBranch) {
+		defaultBranch := data.DefaultBranch.ValueString()
+		update.DefaultBranch = &defaultBranch
+	}
+	if update.Name != nil || update.DefaultBranch != nil {
+		err = gitService.UpdateRepository(ctx, projectId, repositoryId, &update)
+		if err != nil {
+			resp.Diagnostics.AddError("Error", err.Error())
+			return
+		}
+	}
+
+	if !state.Disabled.ValueBool() && data.Disabled.ValueBool() {
+		err = gitService.SetRepositoryDisabled(ctx, projectId, repositoryId, true)
+		if err != nil {
+			resp.Diagnostics.AddError("Error", err.Error())
+			return
+		}
+	}
+
+	repository, err := gitService.GetRepository(ctx, data.ProjectId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.setRepository(repository)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GitRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GitRepositoryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repositoryId, err := uuid.Parse(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	gitService := services.NewGitService(r.client)
+
+	err = gitService.DeleteRepository(ctx, data.ProjectId.ValueString(), repositoryId)
+	if err != nil && !services.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+}
+
+func (r *GitRepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Repositories are imported as <project ID>/<repository ID or name>, Read replaces the name by the ID.
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Error", fmt.Sprintf("invalid import ID %s, expected <project ID>/<repository ID or name>", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+func (m *GitRepositoryResourceModel) setRepository(repository *services.GitRepository) {
+	m.Id = types.StringValue(repository.Id.String())
+	m.Name = types.StringPointerValue(repository.Name)
+	m.DefaultBranch = types.StringPointerValue(repository.DefaultBranch)
+	m.Disabled = types.BoolValue(repository.IsDisabled != nil && *repository.IsDisabled)
+	// The parent of a fork is only tracked when configured, an imported fork would be replaced otherwise.
+	if !m.ParentRepositoryId.IsNull() && repository.ParentRepository != nil && repository.ParentRepository.Id != nil {
+		m.ParentRepositoryId = types.StringValue(repository.ParentRepository.Id.String())
+	}
+	m.RemoteUrl = types.StringPointerValue(repository.RemoteUrl)
+	m.SshUrl = types.StringPointerValue(repository.SshUrl)
+	m.WebUrl = types.StringPointerValue(repository.WebUrl)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &GitRepositoryTokenFunction{}
+
+func NewGitRepositoryTokenFunction() function.Function {
+	return &GitRepositoryTokenFunction{}
+}
+
+// GitRepositoryTokenFunction defines the function implementation.
+type GitRepositoryTokenFunction struct{}
+
+func (f *GitRepositoryTokenFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "git_repository_token"
+}
+
+func (f *GitRepositoryTokenFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Security token of a Git repository",
+		MarkdownDescription: "Returns the security token of a Git repository in the `Git Repositories` security namespace, or of all repositories of the project when `repository_id` is empty",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "project_id",
+				MarkdownDescription: "The ID of the project",
+			},
+			function.StringParameter{
+				Name:                "repository_id",
+				MarkdownDescription: "The ID of the repository, or an empty string for all repositories of the project",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *GitRepositoryTokenFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var projectId, repositoryId string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &projectId, &repositoryId))
+	if resp.Error != nil {
+		return
+	}
+	if projectId == "" {
+		resp.Error = function.NewArgumentFuncError(0, "project_id must not be empty")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, services.GitRepositoryToken(projectId, repositoryId, "")))
+}
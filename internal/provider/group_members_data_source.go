@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GroupMembersDataSource{}
+
+func NewGroupMembersDataSource() datasource.DataSource {
+	log.Println("NewGroupMembersDataSource")
+	return &GroupMembersDataSource{}
+}
+
+// GroupMembersDataSource defines the data source implementation.
+type GroupMembersDataSource struct {
+	client *identity.ClientImpl
+}
+
+// GroupMembersDataSourceModel describes the data source data model.
+type GroupMembersDataSourceModel struct {
+	Group    types.String       `tfsdk:"group"`
+	Expanded types.Bool         `tfsdk:"expanded"`
+	Members  []GroupMemberModel `tfsdk:"members"`
+}
+
+type GroupMemberModel struct {
+	Id                types.String   `tfsdk:"id"`
+	DisplayName       types.String   `tfsdk:"display_name"`
+	SubjectDescriptor types.String   `tfsdk:"subject_descriptor"`
+	Descriptor        types.String   `tfsdk:"descriptor"`
+	IsGroup           types.Bool     `tfsdk:"is_group"`
+	Path              []types.String `tfsdk:"path"`
+}
+
+func (d *GroupMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_members"
+}
+
+func (d *GroupMembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Group members",
+		Attributes: map[string]schema.Attribute{
+			"group": schema.StringAttribute{
+				Description: "The display name of the group",
+				Required:    true,
+			},
+			"expanded": schema.BoolAttribute{
+				Description: "Resolve nested groups and return every effective user of the group instead of the direct members",
+				Optional:    true,
+			},
+			"members": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The identity ID",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The display name of the identity",
+						},
+						"subject_descriptor": schema.StringAttribute{
+							Computed:    true,
+							Description: "The subject descriptor of the identity",
+						},
+						"descriptor": schema.StringAttribute{
+							Computed:    true,
+							Description: "The descriptor of the identity",
+						},
+						"is_group": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the identity is a group",
+						},
+						"path": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "The groups through which the membership is inherited, starting with the queried group",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GroupMembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	log.Println("Configure GroupMembersDataSource")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.IdentityClient
+}
+
+func (d *GroupMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupMembersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityService := services.NewIdentityService(d.client)
+
+	var members []services.GroupMember
+	if data.Expanded.ValueBool() {
+		var response, err = identityService.GetExpandedGroupMembers(ctx, data.Group.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error", err.Error())
+			return
+		}
+		members = *response
+	} else {
+		var response, err = identityService.GetGroupMembers(ctx, data.Group.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error", err.Error())
+			return
+		}
+		for _, member := range *response {
+			members = append(members, services.GroupMember{Identity: member, Path: []string{data.Group.ValueString()}})
+		}
+	}
+
+	data.Members = []GroupMemberModel{}
+	for _, member := range members {
+		memberModel := GroupMemberModel{
+			Id:          types.StringValue(member.Identity.Id.String()),
+			DisplayName: types.StringValue(services.IdentityDisplayName(&member.Identity)),
+			IsGroup:     types.BoolValue(member.Identity.IsContainer != nil && *member.Identity.IsContainer),
+		}
+		if member.Identity.SubjectDescriptor != nil {
+			memberModel.SubjectDescriptor = types.StringValue(*member.Identity.SubjectDescriptor)
+		}
+		if member.Identity.Descriptor != nil {
+			memberModel.Descriptor = types.StringValue(*member.Identity.Descriptor)
+		}
+		for _, group := range member.Path {
+			memberModel.Path = append(memberModel.Path, types.StringValue(group))
+		}
+
+		data.Members = append(data.Members, memberModel)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
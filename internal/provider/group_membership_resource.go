@@ -54,7 +54,7 @@ func (r *GroupMembershipResource) Schema(ctx context.Context, req resource.Schem
 			},
 			"members": schema.ListAttribute{
 				ElementType:         types.StringType,
-				MarkdownDescription: "List of members to add to the group",
+				MarkdownDescription: "List of members to add to the group, given as IDs, descriptors, subject descriptors or names that match exactly one identity",
 				Optional:            false,
 				Required:            true,
 				Computed:            false,
@@ -74,18 +74,18 @@ func (r *GroupMembershipResource) Configure(ctx context.Context, req resource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*identity.ClientImpl)
+	clients, ok := req.ProviderData.(*AzdoClients)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *identity.ClientImpl, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = clients.IdentityClient
 }
 
 func (r *GroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -102,7 +102,7 @@ func (r *GroupMembershipResource) Create(ctx context.Context, req resource.Creat
 
 	var foundmembers []*identity.Identity
 	for _, member := range data.Members {
-		var response, error = identityService.GetIdentityByName(ctx, member.ValueString())
+		var response, error = identityService.ResolveIdentity(ctx, member.ValueString())
 
 		if error != nil {
 			resp.Diagnostics.AddError("error", error.Error())
@@ -123,7 +123,7 @@ func (r *GroupMembershipResource) Create(ctx context.Context, req resource.Creat
 			resp.Diagnostics.AddError("Error", err.Error())
 			return
 		}
-		tflog.Info(ctx, fmt.Sprintf("Added member %s to group: %s", *foundIdentity.ProviderDisplayName, *foundGroup.ProviderDisplayName))
+		tflog.Info(ctx, fmt.Sprintf("Added member %s to group: %s", services.IdentityDisplayName(foundIdentity), services.IdentityDisplayName(foundGroup)))
 	}
 
 	// Save data into Terraform state
@@ -189,36 +189,30 @@ func (r *GroupMembershipResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	var toAddMembers []identity.Identity
-	for _, stateMember := range data.Members {
-		containsMember := slices.ContainsFunc(*members, func(m identity.Identity) bool {
-			displayName := *m.ProviderDisplayName
-			if m.CustomDisplayName != nil {
-				displayName = *m.CustomDisplayName
-			}
-			return displayName == stateMember.ValueString()
-		})
-
-		if !containsMember {
-			foundMember, err := identityService.GetIdentityByName(ctx, stateMember.ValueString())
-			if err != nil {
-				resp.Diagnostics.AddError("Error", err.Error())
-				return
-			}
-			toAddMembers = append(toAddMembers, *foundMember)
-		}
+	// Members are compared by identity ID, the configured members can be IDs, descriptors or names.
+	configured, err := resolveTeamIdentities(ctx, identityService, data.Members)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
 	}
 
 	var toRemoveMembers []identity.Identity
 	for _, member := range *members {
-		displayName := *member.ProviderDisplayName
-		if member.CustomDisplayName != nil {
-			displayName = *member.CustomDisplayName
+		if member.Id == nil {
+			continue
+		}
+		if _, ok := configured[member.Id.String()]; !ok {
+			toRemoveMembers = append(toRemoveMembers, member)
 		}
+	}
 
-		containsMember := slices.Contains(data.Members, types.StringValue(displayName))
+	var toAddMembers []identity.Identity
+	for id, foundMember := range configured {
+		containsMember := slices.ContainsFunc(*members, func(m identity.Identity) bool {
+			return m.Id != nil && m.Id.String() == id
+		})
 		if !containsMember {
-			toRemoveMembers = append(toRemoveMembers, member)
+			toAddMembers = append(toAddMembers, *foundMember)
 		}
 	}
 
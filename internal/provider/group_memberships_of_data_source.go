@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GroupMembershipsOfDataSource{}
+
+func NewGroupMembershipsOfDataSource() datasource.DataSource {
+	log.Println("NewGroupMembershipsOfDataSource")
+	return &GroupMembershipsOfDataSource{}
+}
+
+// GroupMembershipsOfDataSource defines the data source implementation.
+type GroupMembershipsOfDataSource struct {
+	client *identity.ClientImpl
+}
+
+// GroupMembershipsOfDataSourceModel describes the data source data model.
+type GroupMembershipsOfDataSourceModel struct {
+	Identity types.String           `tfsdk:"identity"`
+	Expanded types.Bool             `tfsdk:"expanded"`
+	Groups   []GroupMembershipModel `tfsdk:"groups"`
+}
+
+type GroupMembershipModel struct {
+	Id                types.String `tfsdk:"id"`
+	DisplayName       types.String `tfsdk:"display_name"`
+	SubjectDescriptor types.String `tfsdk:"subject_descriptor"`
+	Descriptor        types.String `tfsdk:"descriptor"`
+	ScopeName         types.String `tfsdk:"scope_name"`
+	IsDirect          types.Bool   `tfsdk:"is_direct"`
+}
+
+func (d *GroupMembershipsOfDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_memberships_of"
+}
+
+func (d *GroupMembershipsOfDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Group memberships of an identity",
+		Attributes: map[string]schema.Attribute{
+			"identity": schema.StringAttribute{
+				Description: "The ID, descriptor, subject descriptor or name of the identity to look up the group memberships for, a name must match exactly one identity",
+				Required:    true,
+			},
+			"expanded": schema.BoolAttribute{
+				Description: "Also return the groups the identity is an indirect member of through nested groups",
+				Optional:    true,
+			},
+			"groups": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The group ID",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The display name of the group",
+						},
+						"subject_descriptor": schema.StringAttribute{
+							Computed:    true,
+							Description: "The subject descriptor of the group",
+						},
+						"descriptor": schema.StringAttribute{
+							Computed:    true,
+							Description: "The descriptor of the group",
+						},
+						"scope_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the scope (collection or project) the group belongs to",
+						},
+						"is_direct": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the identity is a direct member of the group",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GroupMembershipsOfDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	log.Println("Configure GroupMembershipsOfDataSource")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.IdentityClient
+}
+
+func (d *GroupMembershipsOfDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupMembershipsOfDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityService := services.NewIdentityService(d.client)
+
+	var member, err = identityService.ResolveIdentity(ctx, data.Identity.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	directGroups, err := identityService.GetGroupMembershipsOf(ctx, member, identity.QueryMembershipValues.Direct)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	directGroupIds := map[string]bool{}
+	for _, group := range *directGroups {
+		directGroupIds[group.Id.String()] = true
+	}
+
+	groups := directGroups
+	if data.Expanded.ValueBool() {
+		groups, err = identityService.GetGroupMembershipsOf(ctx, member, identity.QueryMembershipValues.ExpandedUp)
+		if err != nil {
+			resp.Diagnostics.AddError("Error", err.Error())
+			return
+		}
+	}
+
+	data.Groups = []GroupMembershipModel{}
+	for _, group := range *groups {
+		groupModel := GroupMembershipModel{
+			Id:          types.StringValue(group.Id.String()),
+			DisplayName: types.StringValue(services.IdentityDisplayName(&group)),
+			ScopeName:   types.StringValue(services.IdentityProperty(&group, "ScopeName")),
+			IsDirect:    types.BoolValue(directGroupIds[group.Id.String()]),
+		}
+		if group.SubjectDescriptor != nil {
+			groupModel.SubjectDescriptor = types.StringValue(*group.SubjectDescriptor)
+		}
+		if group.Descriptor != nil {
+			groupModel.Descriptor = types.StringValue(*group.Descriptor)
+		}
+
+		data.Groups = append(data.Groups, groupModel)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
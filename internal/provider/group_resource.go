@@ -0,0 +1,288 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-azdo/services"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GroupResource{}
+var _ resource.ResourceWithImportState = &GroupResource{}
+
+func NewGroupResource() resource.Resource {
+	return &GroupResource{}
+}
+
+// GroupResource defines the resource implementation.
+type GroupResource struct {
+	client *identity.ClientImpl
+}
+
+// GroupResourceModel describes the resource data model.
+type GroupResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	ScopeId           types.String `tfsdk:"scope_id"`
+	DisplayName       types.String `tfsdk:"display_name"`
+	Description       types.String `tfsdk:"description"`
+	PrincipalName     types.String `tfsdk:"principal_name"`
+	Descriptor        types.String `tfsdk:"descriptor"`
+	SubjectDescriptor types.String `tfsdk:"subject_descriptor"`
+}
+
+func (r *GroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Group resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The group ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scope_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the collection or project scope to create the group in, see `azdo_identity_scope`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the group, without the scope prefix",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The description of the group",
+			},
+			"principal_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the group including the scope prefix, for example `[Project]\\Group`",
+			},
+			"descriptor": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The descriptor of the group",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subject_descriptor": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The subject descriptor of the group",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *GroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.IdentityClient
+}
+
+func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scopeId, err := uuid.Parse(data.ScopeId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("scope_id"), "Invalid scope ID", err.Error())
+		return
+	}
+
+	identityService := services.NewIdentityService(r.client)
+
+	group, err := identityService.CreateGroup(ctx, scopeId, data.DisplayName.ValueString(), data.Description.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Created group %s", services.IdentityDisplayName(group)))
+
+	// Read the group back, as the create response does not contain all properties.
+	group, err = identityService.GetIdentityById(ctx, group.Id.String())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	setGroupResourceModel(&data, group)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityService := services.NewIdentityService(r.client)
+
+	group, err := identityService.GetIdentityById(ctx, data.Id.ValueString())
+	if services.IsNotFound(err) || (err == nil && group.IsActive != nil && !*group.IsActive) {
+		tflog.Info(ctx, fmt.Sprintf("Group %s no longer exists, removing it from state", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	setGroupResourceModel(&data, group)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityService := services.NewIdentityService(r.client)
+
+	group, err := identityService.GetIdentityById(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	err = identityService.UpdateGroup(ctx, group, data.DisplayName.ValueString(), data.Description.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	group, err = identityService.GetIdentityById(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	setGroupResourceModel(&data, group)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityService := services.NewIdentityService(r.client)
+
+	group, err := identityService.GetIdentityById(ctx, data.Id.ValueString())
+	if services.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	err = identityService.DeleteGroup(ctx, group)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+}
+
+func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	identityService := services.NewIdentityService(r.client)
+
+	// Groups are imported by descriptor, which is resolved to the group ID used in state.
+	groups, err := identityService.GetIdentitiesByDescriptor(ctx, &req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	for _, group := range *groups {
+		if group.Id != nil {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), group.Id.String())...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError("Error", fmt.Sprintf("failed to find group with descriptor %s in azure devops", req.ID))
+}
+
+func setGroupResourceModel(data *GroupResourceModel, group *identity.Identity) {
+	data.Id = types.StringValue(group.Id.String())
+	if scopeId := services.IdentityProperty(group, "ScopeId"); scopeId != "" && !strings.EqualFold(scopeId, data.ScopeId.ValueString()) {
+		data.ScopeId = types.StringValue(scopeId)
+	}
+	data.Description = types.StringValue(services.IdentityProperty(group, "Description"))
+	data.Descriptor = types.StringPointerValue(group.Descriptor)
+	data.SubjectDescriptor = types.StringPointerValue(group.SubjectDescriptor)
+
+	// The provider display name is prefixed with the scope, for example [Project]\Group.
+	principalName := services.IdentityDisplayName(group)
+	data.PrincipalName = types.StringValue(principalName)
+	if _, displayName, found := strings.Cut(principalName, "\\"); found {
+		data.DisplayName = types.StringValue(displayName)
+	} else {
+		data.DisplayName = types.StringValue(principalName)
+	}
+}
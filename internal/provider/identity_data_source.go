@@ -7,15 +7,19 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-azdo/services"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ datasource.DataSource = &IdentitiesDataSource{}
+var _ datasource.DataSource = &IdentityDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &IdentityDataSource{}
 
 func NewIdentityDataSource() datasource.DataSource {
 	log.Println("NewIdentityDataSource")
@@ -37,22 +41,26 @@ func (d *IdentityDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 		MarkdownDescription: "Azdo Identity",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
 				Description: "The identity ID",
 			},
 			"display_name": schema.StringAttribute{
 				Description: "The display name of the identity",
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"project_id": schema.StringAttribute{
 				Description: "The project ID (this is a bandaid fix to allow Terraform to use data sources on non-created projects)",
 				Optional:    true,
 			},
 			"subject_descriptor": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
 				Description: "The subject descriptor of the identity",
 			},
 			"descriptor": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
 				Description: "The descriptor of the identity",
 			},
@@ -60,6 +68,17 @@ func (d *IdentityDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 	}
 }
 
+func (d *IdentityDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("display_name"),
+			path.MatchRoot("descriptor"),
+			path.MatchRoot("subject_descriptor"),
+			path.MatchRoot("id"),
+		),
+	}
+}
+
 func (d *IdentityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	log.Println("Configure IdentityDataSource")
 	// Prevent panic if the provider has not been configured.
@@ -67,18 +86,18 @@ func (d *IdentityDataSource) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*identity.ClientImpl)
+	clients, ok := req.ProviderData.(*AzdoClients)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *identity.ClientImpl, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = clients.IdentityClient
 }
 
 func (d *IdentityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -91,48 +110,83 @@ func (d *IdentityDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	if data.DisplayName.IsUnknown() || data.DisplayName.IsNull() && data.DisplayName == types.StringValue("") {
-		resp.Diagnostics.AddError("Error", "display_name is required")
+	identityService := services.NewIdentityService(d.client)
+
+	var foundIdentity *identity.Identity
+	var err error
+	switch {
+	case !data.Descriptor.IsNull():
+		foundIdentity, err = d.getIdentityByDescriptor(ctx, identityService, data.Descriptor.ValueString())
+	case !data.SubjectDescriptor.IsNull():
+		foundIdentity, err = identityService.GetIdentityBySubjectDescriptor(ctx, data.SubjectDescriptor.ValueString())
+	case !data.Id.IsNull():
+		foundIdentity, err = identityService.GetIdentityById(ctx, data.Id.ValueString())
+	default:
+		foundIdentity, err = d.getIdentityByDisplayName(ctx, data.DisplayName.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
 		return
 	}
-	recurse := true
-	var response, error = d.client.ListGroups(ctx, identity.ListGroupsArgs{Recurse: &recurse})
-	if error != nil {
-		resp.Diagnostics.AddError("Error", error.Error())
+	if foundIdentity == nil || foundIdentity.Id == nil {
+		resp.Diagnostics.AddError("Error", "identity not found in azure devops")
 		return
 	}
 
+	// Only fill in the attributes that were not used for the lookup, so the
+	// configured value is kept as is.
+	if data.Id.IsNull() {
+		data.Id = types.StringValue(foundIdentity.Id.String())
+	}
+	if data.DisplayName.IsNull() && foundIdentity.ProviderDisplayName != nil {
+		data.DisplayName = types.StringValue(*foundIdentity.ProviderDisplayName)
+	}
+	if data.SubjectDescriptor.IsNull() && foundIdentity.SubjectDescriptor != nil {
+		data.SubjectDescriptor = types.StringValue(*foundIdentity.SubjectDescriptor)
+	}
+	if data.Descriptor.IsNull() && foundIdentity.Descriptor != nil {
+		data.Descriptor = types.StringValue(*foundIdentity.Descriptor)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *IdentityDataSource) getIdentityByDisplayName(ctx context.Context, displayName string) (*identity.Identity, error) {
+	recurse := true
+	var response, err = d.client.ListGroups(ctx, identity.ListGroupsArgs{Recurse: &recurse})
+	if err != nil {
+		return nil, err
+	}
+
 	var foundGroupId string
 
 	for _, group := range *response {
-		if group.ProviderDisplayName != nil && *group.ProviderDisplayName == data.DisplayName.ValueString() {
+		if group.ProviderDisplayName != nil && *group.ProviderDisplayName == displayName {
 			foundGroupId = group.Id.String()
 			break
 		}
 	}
 
 	if foundGroupId == "" {
-		resp.Diagnostics.AddError("Error", "Group "+data.DisplayName.ValueString()+" not found")
-		return
+		return nil, fmt.Errorf("Group %s not found", displayName)
 	}
 
-	var identity, err = d.client.ReadIdentity(ctx, identity.ReadIdentityArgs{IdentityId: &foundGroupId})
+	return d.client.ReadIdentity(ctx, identity.ReadIdentityArgs{IdentityId: &foundGroupId})
+}
+
+func (d *IdentityDataSource) getIdentityByDescriptor(ctx context.Context, identityService *services.IdentityService, descriptor string) (*identity.Identity, error) {
+	identities, err := identityService.GetIdentitiesByDescriptor(ctx, &descriptor)
 	if err != nil {
-		resp.Diagnostics.AddError("Error", err.Error())
-	}
-	data = IdentityModel{
-		Id: types.StringValue(identity.Id.String()),
-	}
-	if identity.ProviderDisplayName != nil {
-		data.DisplayName = types.StringValue(*identity.ProviderDisplayName)
-	}
-	if identity.SubjectDescriptor != nil {
-		data.SubjectDescriptor = types.StringValue(*identity.SubjectDescriptor)
+		return nil, err
 	}
-	if identity.Descriptor != nil {
-		data.Descriptor = types.StringValue(*identity.Descriptor)
+
+	// Unknown descriptors are returned as null entries instead of an empty list.
+	for _, foundIdentity := range *identities {
+		if foundIdentity.Id != nil {
+			return &foundIdentity, nil
+		}
 	}
 
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return nil, fmt.Errorf("failed to find identity with descriptor %s in azure devops", descriptor)
 }
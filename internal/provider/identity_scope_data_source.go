@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-azdo/services"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IdentityScopeDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &IdentityScopeDataSource{}
+
+func NewIdentityScopeDataSource() datasource.DataSource {
+	log.Println("NewIdentityScopeDataSource")
+	return &IdentityScopeDataSource{}
+}
+
+// IdentityScopeDataSource defines the data source implementation.
+type IdentityScopeDataSource struct {
+	client *identity.ClientImpl
+}
+
+func (d *IdentityScopeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_scope"
+}
+
+func (d *IdentityScopeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Identity scope",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The scope ID",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the scope, for example the collection or project name",
+			},
+			"scope_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the scope (generic, serviceHost or teamProject)",
+			},
+			"administrators_descriptor": schema.StringAttribute{
+				Computed:    true,
+				Description: "The descriptor of the administrators group of the scope",
+			},
+			"parent_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the parent scope",
+			},
+			"local_scope_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The local ID of the scope",
+			},
+			"subject_descriptor": schema.StringAttribute{
+				Computed:    true,
+				Description: "The subject descriptor of the scope",
+			},
+			"is_active": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the scope is active",
+			},
+			"is_global": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the scope is global",
+			},
+		},
+	}
+}
+
+func (d *IdentityScopeDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("name"),
+			path.MatchRoot("id"),
+		),
+	}
+}
+
+func (d *IdentityScopeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	log.Println("Configure IdentityScopeDataSource")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.IdentityClient
+}
+
+func (d *IdentityScopeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IdentityScopeModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityService := services.NewIdentityService(d.client)
+
+	var scope *identity.IdentityScope
+	if !data.Id.IsNull() {
+		scopeId, err := uuid.Parse(data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid scope ID", err.Error())
+			return
+		}
+		scope, err = identityService.GetScopeById(ctx, scopeId)
+		if err != nil {
+			resp.Diagnostics.AddError("Error", err.Error())
+			return
+		}
+	} else {
+		var err error
+		scope, err = identityService.GetScopeByName(ctx, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error", err.Error())
+			return
+		}
+	}
+
+	scopeModel := newIdentityScopeModel(scope)
+	// Keep the configured lookup value as is.
+	if !data.Id.IsNull() {
+		scopeModel.Id = data.Id
+	}
+	if !data.Name.IsNull() {
+		scopeModel.Name = data.Name
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &scopeModel)...)
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IdentityScopesDataSource{}
+
+func NewIdentityScopesDataSource() datasource.DataSource {
+	log.Println("NewIdentityScopesDataSource")
+	return &IdentityScopesDataSource{}
+}
+
+// IdentityScopesDataSource defines the data source implementation.
+type IdentityScopesDataSource struct {
+	client *identity.ClientImpl
+}
+
+// IdentityScopesDataSourceModel describes the data source data model.
+type IdentityScopesDataSourceModel struct {
+	Scopes []IdentityScopeModel `tfsdk:"scopes"`
+}
+
+type IdentityScopeModel struct {
+	Id                       types.String `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	ScopeType                types.String `tfsdk:"scope_type"`
+	AdministratorsDescriptor types.String `tfsdk:"administrators_descriptor"`
+	ParentId                 types.String `tfsdk:"parent_id"`
+	LocalScopeId             types.String `tfsdk:"local_scope_id"`
+	SubjectDescriptor        types.String `tfsdk:"subject_descriptor"`
+	IsActive                 types.Bool   `tfsdk:"is_active"`
+	IsGlobal                 types.Bool   `tfsdk:"is_global"`
+}
+
+func (d *IdentityScopesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_scopes"
+}
+
+func (d *IdentityScopesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Identity scopes",
+		Attributes: map[string]schema.Attribute{
+			"scopes": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The scope ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the scope",
+						},
+						"scope_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the scope (generic, serviceHost or teamProject)",
+						},
+						"administrators_descriptor": schema.StringAttribute{
+							Computed:    true,
+							Description: "The descriptor of the administrators group of the scope",
+						},
+						"parent_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the parent scope",
+						},
+						"local_scope_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The local ID of the scope",
+						},
+						"subject_descriptor": schema.StringAttribute{
+							Computed:    true,
+							Description: "The subject descriptor of the scope",
+						},
+						"is_active": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the scope is active",
+						},
+						"is_global": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the scope is global",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IdentityScopesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	log.Println("Configure IdentityScopesDataSource")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.IdentityClient
+}
+
+func (d *IdentityScopesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IdentityScopesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityService := services.NewIdentityService(d.client)
+
+	var scopes, err = identityService.ListScopes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	data.Scopes = []IdentityScopeModel{}
+	for _, scope := range *scopes {
+		data.Scopes = append(data.Scopes, newIdentityScopeModel(&scope))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func newIdentityScopeModel(scope *identity.IdentityScope) IdentityScopeModel {
+	scopeModel := IdentityScopeModel{
+		Id:                       types.StringValue(scope.Id.String()),
+		Name:                     types.StringPointerValue(scope.Name),
+		AdministratorsDescriptor: types.StringPointerValue(scope.Administrators),
+		SubjectDescriptor:        types.StringPointerValue(scope.SubjectDescriptor),
+		IsActive:                 types.BoolPointerValue(scope.IsActive),
+		IsGlobal:                 types.BoolPointerValue(scope.IsGlobal),
+	}
+	if scope.ScopeType != nil {
+		scopeModel.ScopeType = types.StringValue(string(*scope.ScopeType))
+	}
+	if scope.ParentId != nil {
+		scopeModel.ParentId = types.StringValue(scope.ParentId.String())
+	}
+	if scope.LocalScopeId != nil {
+		scopeModel.LocalScopeId = types.StringValue(scope.LocalScopeId.String())
+	}
+	return scopeModel
+}
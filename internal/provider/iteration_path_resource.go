@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtracking"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IterationPathResource{}
+var _ resource.ResourceWithImportState = &IterationPathResource{}
+
+// Dates of the iterations, the api stores them as midnight UTC.
+var iterationDateRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+func NewIterationPathResource() resource.Resource {
+	return &IterationPathResource{
+		ClassificationPathResource: ClassificationPathResource{
+			name:           "iteration",
+			description:    "Azdo Iteration path resource",
+			structureGroup: workitemtracking.TreeStructureGroupValues.Iterations,
+		},
+	}
+}
+
+// IterationPathResource extends the area path implementation with the start
+// and finish dates of the iterations.
+type IterationPathResource struct {
+	ClassificationPathResource
+}
+
+// IterationPathResourceModel describes the resource data model.
+type IterationPathResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	NodeId     types.Int64  `tfsdk:"node_id"`
+	ProjectId  types.String `tfsdk:"project_id"`
+	Name       types.String `tfsdk:"name"`
+	ParentPath types.String `tfsdk:"parent_path"`
+	Path       types.String `tfsdk:"path"`
+	StartDate  types.String `tfsdk:"start_date"`
+	FinishDate types.String `tfsdk:"finish_date"`
+}
+
+func (r *IterationPathResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := r.attributes()
+	attributes["start_date"] = schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "The start date of the iteration, formatted as `YYYY-MM-DD`",
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(iterationDateRegex, "must be formatted as YYYY-MM-DD"),
+			stringvalidator.AlsoRequires(path.MatchRoot("finish_date")),
+		},
+	}
+	attributes["finish_date"] = schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "The finish date of the iteration, formatted as `YYYY-MM-DD`",
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(iterationDateRegex, "must be formatted as YYYY-MM-DD"),
+			stringvalidator.AlsoRequires(path.MatchRoot("start_date")),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: r.schemaDescription(),
+		Attributes:          attributes,
+	}
+}
+
+func (r *IterationPathResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IterationPathResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pathData := data.classificationPath()
+	node, err := r.create(ctx, &pathData, data.dateAttributes())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.setClassificationPath(&pathData, node)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IterationPathResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IterationPathResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pathData := data.classificationPath()
+	node, err := r.read(ctx, &pathData)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	if node == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.setClassificationPath(&pathData, node)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IterationPathResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state IterationPathResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pathData, statePathData := data.classificationPath(), state.classificationPath()
+	node, err := r.update(ctx, &pathData, &statePathData, data.dateAttributes())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.setClassificationPath(&pathData, node)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IterationPathResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IterationPathResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pathData := data.classificationPath()
+	err := r.delete(ctx, &pathData)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+}
+
+func (m *IterationPathResourceModel) classificationPath() ClassificationPathResourceModel {
+	return ClassificationPathResourceModel{
+		Id:         m.Id,
+		NodeId:     m.NodeId,
+		ProjectId:  m.ProjectId,
+		Name:       m.Name,
+		ParentPath: m.ParentPath,
+		Path:       m.Path,
+	}
+}
+
+func (m *IterationPathResourceModel) setClassificationPath(pathData *ClassificationPathResourceModel, node *workitemtracking.WorkItemClassificationNode) {
+	m.Id = pathData.Id
+	m.NodeId = pathData.NodeId
+	m.ProjectId = pathData.ProjectId
+	m.Name = pathData.Name
+	m.ParentPath = pathData.ParentPath
+	m.Path = pathData.Path
+
+	m.StartDate = types.StringNull()
+	if startDate := services.ClassificationNodeDate(node, "startDate"); startDate != "" {
+		m.StartDate = types.StringValue(startDate)
+	}
+	m.FinishDate = types.StringNull()
+	if finishDate := services.ClassificationNodeDate(node, "finishDate"); finishDate != "" {
+		m.FinishDate = types.StringValue(finishDate)
+	}
+}
+
+// dateAttributes returns the node attributes holding the dates, null dates
+// clear the dates of the iteration.
+func (m *IterationPathResourceModel) dateAttributes() *map[string]interface{} {
+	attributes := map[string]interface{}{
+		"startDate":  nil,
+		"finishDate": nil,
+	}
+	if !m.StartDate.IsNull() {
+		attributes["startDate"] = m.StartDate.ValueString() + "T00:00:00Z"
+	}
+	if !m.FinishDate.IsNull() {
+		attributes["finishDate"] = m.FinishDate.ValueString() + "T00:00:00Z"
+	}
+	return &attributes
+}
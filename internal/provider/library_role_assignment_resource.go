@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewLibraryRoleAssignmentResource() resource.Resource {
+	return &RoleAssignmentResource{
+		name:            "library",
+		description:     "Azdo Library role assignment resource, for the library of a project or a single variable group",
+		roles:           distributedTaskRoles,
+		roleDescription: "The name of the role, `Reader`, `User`, `Administrator` or `Creator`. `Creator` can only be assigned for the whole library",
+		resourceAttributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the project",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"variable_group_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the variable group, the role applies to the whole library of the project when not set",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+		importAttributes: []string{"project_id", "variable_group_id"},
+		scope: func(values map[string]attr.Value) (string, string) {
+			projectId := values["project_id"].(types.String)
+			variableGroupId := int64AttributeString(values["variable_group_id"])
+			if variableGroupId == "" {
+				return services.LibraryRoleScope, services.LibraryRoleResourceId(projectId.ValueString(), variableGroupId)
+			}
+			return services.VariableGroupRoleScope, services.LibraryRoleResourceId(projectId.ValueString(), variableGroupId)
+		},
+	}
+}
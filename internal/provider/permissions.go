@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"terraform-provider-azdo/services"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+)
+
+// Helpers shared by the resources that manage the permissions of a principal
+// on a security token.
+
+func permissionsId(token string, principal string) string {
+	return token + "#" + principal
+}
+
+func permissionNames(permissions map[string]types.String) []string {
+	names := make([]string, 0, len(permissions))
+	for name := range permissions {
+		names = append(names, name)
+	}
+	return names
+}
+
+func permissionsFromModel(permissions map[string]types.String) map[string]string {
+	values := make(map[string]string, len(permissions))
+	for name, value := range permissions {
+		values[name] = value.ValueString()
+	}
+	return values
+}
+
+// permissionsFromPlan returns the planned permissions, with the permissions
+// that were removed from the configuration reset to NotSet.
+func permissionsFromPlan(planned map[string]types.String, prior map[string]types.String) map[string]string {
+	values := permissionsFromModel(planned)
+	for name := range prior {
+		if _, ok := planned[name]; !ok {
+			values[name] = services.PermissionNotSet
+		}
+	}
+	return values
+}
+
+func permissionsToModel(permissions map[string]string) map[string]types.String {
+	values := make(map[string]types.String, len(permissions))
+	for name, value := range permissions {
+		values[name] = types.StringValue(value)
+	}
+	return values
+}
+
+func permissionsFromList(names []types.String) []string {
+	values := make([]string, 0, len(names))
+	for _, name := range names {
+		values = append(values, name.ValueString())
+	}
+	return values
+}
+
+// actionNamesToModel converts action names read from Azure DevOps to the
+// model, keeping the spelling of configured names that refer to the same
+// action, in another case or by display name.
+func actionNamesToModel(namespace *security.SecurityNamespaceDescription, names []string, configured []types.String) []types.String {
+	values := make([]types.String, 0, len(names))
+	for _, name := range names {
+		value := types.StringValue(name)
+		bit, err := services.GetActionBit(namespace, name)
+		if err == nil {
+			for _, configuredName := range configured {
+				if configuredBit, err := services.GetActionBit(namespace, configuredName.ValueString()); err == nil && configuredBit == bit {
+					value = configuredName
+				}
+			}
+		}
+		values = append(values, value)
+	}
+	return values
+}
+
+// getSecurityNamespace looks up a security namespace by ID, or by name when the
+// ID is not known.
+func getSecurityNamespace(ctx context.Context, securityService *services.SecurityService, namespaceId types.String, namespaceName types.String) (*security.SecurityNamespaceDescription, error) {
+	if namespaceId.IsNull() || namespaceId.IsUnknown() {
+		return securityService.GetNamespaceByName(ctx, namespaceName.ValueString())
+	}
+
+	id, err := uuid.Parse(namespaceId.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("invalid security namespace ID %s: %w", namespaceId.ValueString(), err)
+	}
+	return securityService.GetNamespace(ctx, id)
+}
+
+// AccessControlListEntryModel describes an entry of an access control list
+// managed as a whole.
+type AccessControlListEntryModel struct {
+	Principal types.String   `tfsdk:"principal"`
+	Allow     []types.String `tfsdk:"allow"`
+	Deny      []types.String `tfsdk:"deny"`
+}
+
+// accessControlListEntriesAttributes returns the attributes of the entries of
+// an access control list managed as a whole.
+func accessControlListEntriesAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"principal": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The descriptor of the identity the entry applies to, see `azdo_identity`",
+		},
+		"allow": schema.SetAttribute{
+			ElementType:         types.StringType,
+			Optional:            true,
+			MarkdownDescription: "Names of the actions that are allowed",
+		},
+		"deny": schema.SetAttribute{
+			ElementType:         types.StringType,
+			Optional:            true,
+			MarkdownDescription: "Names of the actions that are denied",
+		},
+	}
+}
+
+// accessControlEntriesFromModel converts the entries of the model to the
+// access control entries of an access control list, keyed on descriptor.
+func accessControlEntriesFromModel(namespace *security.SecurityNamespaceDescription, entries []AccessControlListEntryModel) (map[string]security.AccessControlEntry, error) {
+	aces := make(map[string]security.AccessControlEntry, len(entries))
+	for _, entry := range entries {
+		principal := entry.Principal.ValueString()
+		allow, err := services.GetActionsBits(namespace, permissionsFromList(entry.Allow))
+		if err != nil {
+			return nil, err
+		}
+		deny, err := services.GetActionsBits(namespace, permissionsFromList(entry.Deny))
+		if err != nil {
+			return nil, err
+		}
+		if allow == 0 && deny == 0 {
+			return nil, fmt.Errorf("the entry of %s must allow or deny at least one action", principal)
+		}
+		if allow&deny != 0 {
+			return nil, fmt.Errorf("actions %s can not be both allowed and denied for %s", strings.Join(services.GetActionNames(namespace, allow&deny), ", "), principal)
+		}
+		if _, exists := aces[principal]; exists {
+			return nil, fmt.Errorf("principal %s has more than one entry", principal)
+		}
+		aces[principal] = security.AccessControlEntry{Descriptor: &principal, Allow: &allow, Deny: &deny}
+	}
+	return aces, nil
+}
+
+// accessControlEntriesToModel converts the access control entries of an access
+// control list to the model. Entries without any permission are left out and
+// the spelling of configured principals and action names is kept.
+func accessControlEntriesToModel(namespace *security.SecurityNamespaceDescription, aces map[string]security.AccessControlEntry, prior []AccessControlListEntryModel) []AccessControlListEntryModel {
+	descriptors := make([]string, 0, len(aces))
+	for descriptor := range aces {
+		descriptors = append(descriptors, descriptor)
+	}
+	slices.Sort(descriptors)
+
+	entries := make([]AccessControlListEntryModel, 0, len(aces))
+	for _, descriptor := range descriptors {
+		ace := aces[descriptor]
+		var allowNames, denyNames []string
+		if ace.Allow != nil {
+			allowNames = services.GetActionNames(namespace, *ace.Allow)
+		}
+		if ace.Deny != nil {
+			denyNames = services.GetActionNames(namespace, *ace.Deny)
+		}
+		if len(allowNames) == 0 && len(denyNames) == 0 {
+			continue
+		}
+
+		entry := AccessControlListEntryModel{Principal: types.StringValue(descriptor)}
+		var priorEntry AccessControlListEntryModel
+		for _, candidate := range prior {
+			if strings.EqualFold(candidate.Principal.ValueString(), descriptor) {
+				priorEntry = candidate
+				entry.Principal = candidate.Principal
+			}
+		}
+		if len(allowNames) > 0 || priorEntry.Allow != nil {
+			entry.Allow = actionNamesToModel(namespace, allowNames, priorEntry.Allow)
+		}
+		if len(denyNames) > 0 || priorEntry.Deny != nil {
+			entry.Deny = actionNamesToModel(namespace, denyNames, priorEntry.Deny)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-azdo/services"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PermissionsResource{}
+
+// PermissionsResource defines the resource implementation shared by the
+// permissions resources whose security token is built from their attributes,
+// they only differ in their security namespace and token attributes.
+type PermissionsResource struct {
+	client *security.ClientImpl
+	// name is the prefix of the resource type name, for example git.
+	name        string
+	description string
+	namespaceId uuid.UUID
+	// examples lists some permission names of the security namespace.
+	examples string
+	// tokenAttributes are the attributes the security token is built from,
+	// next to the project.
+	tokenAttributes map[string]schema.Attribute
+	// token builds the security token from the project and the values of the token attributes.
+	token func(projectId string, values map[string]attr.Value) string
+}
+
+// PermissionsResourceModel describes the resource data model, next to the token attributes.
+type PermissionsResourceModel struct {
+	Id          types.String            `tfsdk:"id"`
+	ProjectId   types.String            `tfsdk:"project_id"`
+	Principal   types.String            `tfsdk:"principal"`
+	Permissions map[string]types.String `tfsdk:"permissions"`
+}
+
+func (r *PermissionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.name + "_permissions"
+}
+
+func (r *PermissionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The security token and principal the permissions are set for",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"project_id": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The ID of the project",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"principal": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The descriptor of the identity to set the permissions for, see `azdo_identity`",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"permissions": schema.MapAttribute{
+			ElementType:         types.StringType,
+			Required:            true,
+			MarkdownDescription: fmt.Sprintf("Map of permission names, for example %s, to `Allow`, `Deny` or `NotSet`. The display names of the permissions are accepted as well", r.examples),
+			Validators: []validator.Map{
+				mapvalidator.SizeAtLeast(1),
+				mapvalidator.ValueStringsAre(stringvalidator.OneOf(services.PermissionValues...)),
+			},
+		},
+	}
+	for name, attribute := range r.tokenAttributes {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: r.description,
+
+		Attributes: attributes,
+	}
+}
+
+func (r *PermissionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.SecurityClient
+}
+
+func (r *PermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PermissionsResourceModel
+
+	// Read Terraform plan data into the model
+	values, diags := r.getModel(ctx, req.Plan, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	token := r.token(data.ProjectId.ValueString(), values)
+	err := securityService.SetPermissions(ctx, r.namespaceId, token, data.Principal.ValueString(), permissionsFromModel(data.Permissions))
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Id = types.StringValue(permissionsId(token, data.Principal.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(r.setModel(ctx, &resp.State, &data, values)...)
+}
+
+func (r *PermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PermissionsResourceModel
+
+	// Read Terraform prior state data into the model
+	values, diags := r.getModel(ctx, req.State, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	permissions, err := securityService.GetPermissions(ctx, r.namespaceId, r.token(data.ProjectId.ValueString(), values), data.Principal.ValueString(), permissionNames(data.Permissions))
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Permissions = permissionsToModel(permissions)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(r.setModel(ctx, &resp.State, &data, values)...)
+}
+
+func (r *PermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state PermissionsResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	values, diags := r.getModel(ctx, req.Plan, &data)
+	resp.Diagnostics.Append(diags...)
+	_, diags = r.getModel(ctx, req.State, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	err := securityService.SetPermissions(ctx, r.namespaceId, r.token(data.ProjectId.ValueString(), values), data.Principal.ValueString(), permissionsFromPlan(data.Permissions, state.Permissions))
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(r.setModel(ctx, &resp.State, &data, values)...)
+}
+
+func (r *PermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PermissionsResourceModel
+
+	// Read Terraform prior state data into the model
+	values, diags := r.getModel(ctx, req.State, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(r.client)
+
+	err := securityService.ResetPermissions(ctx, r.namespaceId, r.token(data.ProjectId.ValueString(), values), data.Principal.ValueString(), permissionNames(data.Permissions))
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+}
+
+// getModel reads the plan or state into the model and returns the values of the token attributes.
+func (r *PermissionsResource) getModel(ctx context.Context, source interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}, data *PermissionsResourceModel) (map[string]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	diags.Append(source.GetAttribute(ctx, path.Root("id"), &data.Id)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("project_id"), &data.ProjectId)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("principal"), &data.Principal)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("permissions"), &data.Permissions)...)
+
+	values := make(map[string]attr.Value, len(r.tokenAttributes))
+	for name := range r.tokenAttributes {
+		var value attr.Value
+		diags.Append(source.GetAttribute(ctx, path.Root(name), &value)...)
+		values[name] = value
+	}
+	return values, diags
+}
+
+// setModel saves the model and the values of the token attributes into the state.
+func (r *PermissionsResource) setModel(ctx context.Context, state *tfsdk.State, data *PermissionsResourceModel, values map[string]attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), data.Id)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("project_id"), data.ProjectId)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("principal"), data.Principal)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("permissions"), data.Permissions)...)
+
+	for name, value := range values {
+		diags.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+	}
+	return diags
+}
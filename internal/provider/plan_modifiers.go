@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// useStateForUnknownUnlessChanged returns a plan modifier that copies the
+// prior state value of a computed attribute into the plan, like
+// UseStateForUnknown, unless one of the attributes it is derived from changes.
+func useStateForUnknownUnlessChanged(paths ...path.Path) planmodifier.String {
+	return useStateForUnknownUnlessChangedModifier{paths: paths}
+}
+
+type useStateForUnknownUnlessChangedModifier struct {
+	paths []path.Path
+}
+
+func (m useStateForUnknownUnlessChangedModifier) Description(ctx context.Context) string {
+	return "Once set, the value of this attribute in state will not change unless the attributes it is derived from change."
+}
+
+func (m useStateForUnknownUnlessChangedModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useStateForUnknownUnlessChangedModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to keep on create, or when the value is configured.
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, p := range m.paths {
+		var planValue, stateValue attr.Value
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, p, &planValue)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, p, &stateValue)...)
+		if resp.Diagnostics.HasError() || !planValue.Equal(stateValue) {
+			return
+		}
+	}
+
+	resp.PlanValue = req.StateValue
+}
@@ -0,0 +1,497 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"terraform-provider-azdo/services"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PolicyConfigurationResource{}
+var _ resource.ResourceWithImportState = &PolicyConfigurationResource{}
+
+func NewPolicyConfigurationResource() resource.Resource {
+	return &PolicyConfigurationResource{}
+}
+
+// PolicyConfigurationResource defines the resource implementation.
+type PolicyConfigurationResource struct {
+	client *policy.ClientImpl
+}
+
+// PolicyConfigurationResourceModel describes the resource data model.
+type PolicyConfigurationResourceModel struct {
+	Id        types.String             `tfsdk:"id"`
+	ProjectId types.String             `tfsdk:"project_id"`
+	TypeId    types.String             `tfsdk:"type_id"`
+	Enabled   types.Bool               `tfsdk:"enabled"`
+	Blocking  types.Bool               `tfsdk:"blocking"`
+	Scopes    []BranchPolicyScopeModel `tfsdk:"scope"`
+	Settings  types.String             `tfsdk:"settings"`
+}
+
+func (r *PolicyConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_configuration"
+}
+
+func (r *PolicyConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Policy configuration resource. Manages a policy of any type with its settings as a JSON document",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the policy configuration",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the project",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the policy type, see `azdo_policy_types`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the policy is enabled",
+			},
+			"blocking": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the policy is required to complete pull requests, the policy is optional when false",
+			},
+			"scope": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The repositories and branches the policy applies to. `settings` must not contain a `scope` when set",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"repository_id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The ID of the repository, the policy applies to all repositories of the project when not set",
+						},
+						"ref_name": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The name of the branch, for example `refs/heads/main`, or the prefix of the branch names when `match_type` is `Prefix`. Not used when `match_type` is `DefaultBranch`",
+						},
+						"match_type": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("Exact"),
+							MarkdownDescription: "How `ref_name` is matched: `Exact`, `Prefix` or `DefaultBranch`",
+							Validators: []validator.String{
+								stringvalidator.OneOf(branchPolicyMatchTypes...),
+							},
+						},
+					},
+				},
+			},
+			"settings": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The settings of the policy as a JSON document, for example `jsonencode({ minimumApproverCount = 2 })`. Documents that only differ in formatting or key order are considered equal, keys not in the configured document that the server adds with default values are ignored",
+				Validators: []validator.String{
+					jsonObjectValidator{},
+					policySettingsScopeValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					jsonSemanticEqualityModifier{},
+				},
+			},
+		},
+	}
+}
+
+func (r *PolicyConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.PolicyClient
+}
+
+func (r *PolicyConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PolicyConfigurationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := newPolicyConfiguration(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	policyService := services.NewPolicyService(r.client)
+
+	configuration, err = policyService.CreatePolicyConfiguration(ctx, data.ProjectId.ValueString(), configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Id = types.StringValue(strconv.Itoa(*configuration.Id))
+
+	// The planned settings are kept, the server may add default values that are ignored on refresh.
+	settings := data.Settings
+	err = readPolicyConfiguration(&data, configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Settings = settings
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PolicyConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PolicyConfigurationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configurationId, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", fmt.Sprintf("invalid policy configuration ID %s: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	policyService := services.NewPolicyService(r.client)
+
+	configuration, err := policyService.GetPolicyConfiguration(ctx, data.ProjectId.ValueString(), configurationId)
+	if services.IsNotFound(err) || (err == nil && configuration.IsDeleted != nil && *configuration.IsDeleted) {
+		tflog.Info(ctx, fmt.Sprintf("Policy configuration %d no longer exists, removing it from state", configurationId))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	err = readPolicyConfiguration(&data, configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PolicyConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PolicyConfigurationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configurationId, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", fmt.Sprintf("invalid policy configuration ID %s: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	configuration, err := newPolicyConfiguration(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	policyService := services.NewPolicyService(r.client)
+
+	configuration, err = policyService.UpdatePolicyConfiguration(ctx, data.ProjectId.ValueString(), configurationId, configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	settings := data.Settings
+	err = readPolicyConfiguration(&data, configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Settings = settings
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PolicyConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PolicyConfigurationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configurationId, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", fmt.Sprintf("invalid policy configuration ID %s: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	policyService := services.NewPolicyService(r.client)
+
+	err = policyService.DeletePolicyConfiguration(ctx, data.ProjectId.ValueString(), configurationId)
+	if err != nil && !services.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+}
+
+func (r *PolicyConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Policies are imported as <project ID>/<policy configuration ID>.
+	projectId, configurationId, found := strings.Cut(req.ID, "/")
+	if !found || projectId == "" || configurationId == "" {
+		resp.Diagnostics.AddError("Error", fmt.Sprintf("invalid import ID %s, expected <project ID>/<policy configuration ID>", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), configurationId)...)
+}
+
+func newPolicyConfiguration(data *PolicyConfigurationResourceModel) (*policy.PolicyConfiguration, error) {
+	typeId, err := uuid.Parse(data.TypeId.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("invalid policy type ID %s: %w", data.TypeId.ValueString(), err)
+	}
+
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(data.Settings.ValueString()), &document); err != nil {
+		return nil, fmt.Errorf("invalid policy settings: %w", err)
+	}
+	if document == nil {
+		document = map[string]interface{}{}
+	}
+	if data.Scopes != nil {
+		document["scope"] = branchPolicyScopesToDocument(data.Scopes)
+	}
+
+	return &policy.PolicyConfiguration{
+		Type:       &policy.PolicyTypeRef{Id: &typeId},
+		IsEnabled:  data.Enabled.ValueBoolPointer(),
+		IsBlocking: data.Blocking.ValueBoolPointer(),
+		Settings:   document,
+	}, nil
+}
+
+func readPolicyConfiguration(data *PolicyConfigurationResourceModel, configuration *policy.PolicyConfiguration) error {
+	if configuration.Type != nil && configuration.Type.Id != nil && !strings.EqualFold(configuration.Type.Id.String(), data.TypeId.ValueString()) {
+		data.TypeId = types.StringValue(configuration.Type.Id.String())
+	}
+	data.Enabled = types.BoolValue(configuration.IsEnabled != nil && *configuration.IsEnabled)
+	data.Blocking = types.BoolValue(configuration.IsBlocking != nil && *configuration.IsBlocking)
+
+	document, _ := configuration.Settings.(map[string]interface{})
+	if document == nil {
+		document = map[string]interface{}{}
+	}
+	// The scope is only split from the settings when it is managed by the scope attribute.
+	if data.Scopes != nil {
+		data.Scopes = branchPolicyScopesFromDocument(document, data.Scopes)
+		delete(document, "scope")
+	}
+
+	settings, err := json.Marshal(document)
+	if err != nil {
+		return fmt.Errorf("failed to encode policy settings: %w", err)
+	}
+	// Keep the configured settings when the server only added keys with default values.
+	if data.Settings.IsNull() || !jsonSubset(data.Settings.ValueString(), string(settings)) {
+		data.Settings = types.StringValue(string(settings))
+	}
+	return nil
+}
+
+// jsonSubset reports whether every value of the JSON document a is found in
+// the JSON document b. Objects of b may hold keys that are not in a, arrays
+// must have the same length.
+func jsonSubset(a string, b string) bool {
+	var aValue, bValue interface{}
+	if err := json.Unmarshal([]byte(a), &aValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &bValue); err != nil {
+		return false
+	}
+	return jsonValueSubset(aValue, bValue)
+}
+
+func jsonValueSubset(a interface{}, b interface{}) bool {
+	switch aValue := a.(type) {
+	case map[string]interface{}:
+		bValue, ok := b.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range aValue {
+			other, found := bValue[key]
+			if !found || !jsonValueSubset(value, other) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		bValue, ok := b.([]interface{})
+		if !ok || len(aValue) != len(bValue) {
+			return false
+		}
+		for i := range aValue {
+			if !jsonValueSubset(aValue[i], bValue[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+// jsonEqual reports whether two JSON documents hold the same values, ignoring
+// formatting and the order of object keys.
+func jsonEqual(a string, b string) bool {
+	var aValue, bValue interface{}
+	if err := json.Unmarshal([]byte(a), &aValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &bValue); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+// jsonSemanticEqualityModifier keeps the JSON document in state when the
+// configured document only differs in formatting or key order.
+type jsonSemanticEqualityModifier struct{}
+
+func (m jsonSemanticEqualityModifier) Description(ctx context.Context) string {
+	return "Keeps the value in state when the configured JSON document is semantically equal."
+}
+
+func (m jsonSemanticEqualityModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m jsonSemanticEqualityModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if jsonEqual(req.StateValue.ValueString(), req.PlanValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// jsonObjectValidator validates that a string holds a JSON object.
+type jsonObjectValidator struct{}
+
+func (v jsonObjectValidator) Description(ctx context.Context) string {
+	return "value must be a JSON object"
+}
+
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonObjectValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &document); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid JSON document", fmt.Sprintf("The value must be a JSON object: %s", err))
+	}
+}
+
+// policySettingsScopeValidator validates that the settings do not hold a scope
+// when it is managed by the scope attribute.
+type policySettingsScopeValidator struct{}
+
+func (v policySettingsScopeValidator) Description(ctx context.Context) string {
+	return "value must not contain a scope when the scope attribute is set"
+}
+
+func (v policySettingsScopeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v policySettingsScopeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var scopes types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("scope"), &scopes)...)
+	if resp.Diagnostics.HasError() || scopes.IsNull() {
+		return
+	}
+
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &document); err != nil {
+		return
+	}
+	if _, found := document["scope"]; found {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid policy settings", "The settings must not contain a scope when the scope attribute is set")
+	}
+}
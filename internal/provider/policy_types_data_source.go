@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PolicyTypesDataSource{}
+
+func NewPolicyTypesDataSource() datasource.DataSource {
+	log.Println("NewPolicyTypesDataSource")
+	return &PolicyTypesDataSource{}
+}
+
+// PolicyTypesDataSource defines the data source implementation.
+type PolicyTypesDataSource struct {
+	client *policy.ClientImpl
+}
+
+// PolicyTypesDataSourceModel describes the data source data model.
+type PolicyTypesDataSourceModel struct {
+	ProjectId   types.String      `tfsdk:"project_id"`
+	PolicyTypes []PolicyTypeModel `tfsdk:"policy_types"`
+}
+
+type PolicyTypeModel struct {
+	Id          types.String `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (d *PolicyTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_types"
+}
+
+func (d *PolicyTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Policy types available in a project",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the project",
+				Required:    true,
+			},
+			"policy_types": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The policy type ID",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The display name of the policy type, for example Minimum number of reviewers",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description of the policy type",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PolicyTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	log.Println("Configure PolicyTypesDataSource")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.PolicyClient
+}
+
+func (d *PolicyTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PolicyTypesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyService := services.NewPolicyService(d.client)
+
+	policyTypes, err := policyService.GetPolicyTypes(ctx, data.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	data.PolicyTypes = []PolicyTypeModel{}
+	for _, policyType := range *policyTypes {
+		if policyType.Id == nil {
+			continue
+		}
+		data.PolicyTypes = append(data.PolicyTypes, PolicyTypeModel{
+			Id:          types.StringValue(policyType.Id.String()),
+			DisplayName: types.StringPointerValue(policyType.DisplayName),
+			Description: types.StringPointerValue(policyType.Description),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
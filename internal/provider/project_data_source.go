@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/operations"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ProjectDataSource{}
+
+func NewProjectDataSource() datasource.DataSource {
+	log.Println("NewProjectDataSource")
+	return &ProjectDataSource{}
+}
+
+// ProjectDataSource defines the data source implementation.
+type ProjectDataSource struct {
+	client           *core.ClientImpl
+	operationsClient *operations.ClientImpl
+}
+
+// ProjectDataSourceModel describes the data source data model.
+type ProjectDataSourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	Visibility        types.String `tfsdk:"visibility"`
+	State             types.String `tfsdk:"state"`
+	VersionControl    types.String `tfsdk:"version_control"`
+	ProcessTemplate   types.String `tfsdk:"process_template"`
+	ProcessTemplateId types.String `tfsdk:"process_template_id"`
+}
+
+func (d *ProjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Project",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the project",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the project",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The description of the project",
+			},
+			"visibility": schema.StringAttribute{
+				Computed:    true,
+				Description: "The visibility of the project (private or public)",
+			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The state of the project, for example wellFormed",
+			},
+			"version_control": schema.StringAttribute{
+				Computed:    true,
+				Description: "The version control system of the project (Git or Tfvc)",
+			},
+			"process_template": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the process template of the project",
+			},
+			"process_template_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the process template of the project",
+			},
+		},
+	}
+}
+
+func (d *ProjectDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("name"),
+			path.MatchRoot("id"),
+		),
+	}
+}
+
+func (d *ProjectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	log.Println("Configure ProjectDataSource")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.CoreClient
+	d.operationsClient = clients.OperationsClient
+}
+
+func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectService := services.NewProjectService(d.client, d.operationsClient)
+
+	// The project API accepts both the ID and the name of the project.
+	lookup := data.Id.ValueString()
+	if data.Id.IsNull() {
+		lookup = data.Name.ValueString()
+	}
+
+	project, err := projectService.GetProject(ctx, lookup)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Keep the configured lookup value as is.
+	if data.Id.IsNull() {
+		data.Id = types.StringValue(project.Id.String())
+	}
+	if data.Name.IsNull() {
+		data.Name = types.StringPointerValue(project.Name)
+	}
+	data.Description = types.StringPointerValue(project.Description)
+	if project.Visibility != nil {
+		data.Visibility = types.StringValue(string(*project.Visibility))
+	}
+	if project.State != nil {
+		data.State = types.StringValue(string(*project.State))
+	}
+	if project.Capabilities != nil {
+		if versionControl, ok := (*project.Capabilities)[services.VersionControlCapability]["sourceControlType"]; ok {
+			data.VersionControl = types.StringValue(versionControl)
+		}
+		if templateName, ok := (*project.Capabilities)[services.ProcessTemplateCapability]["templateName"]; ok {
+			data.ProcessTemplate = types.StringValue(templateName)
+		}
+		if templateId, ok := (*project.Capabilities)[services.ProcessTemplateCapability]["templateTypeId"]; ok {
+			data.ProcessTemplateId = types.StringValue(templateId)
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
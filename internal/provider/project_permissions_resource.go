@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewProjectPermissionsResource() resource.Resource {
+	return &PermissionsResource{
+		name:        "project",
+		description: "Azdo Project permissions resource",
+		namespaceId: services.ProjectNamespaceId,
+		examples:    "`DELETE` (Delete team project), `MANAGE_PROPERTIES` (Manage project properties) or `BYPASS_RULES` (Bypass rules on work item updates)",
+		token: func(projectId string, values map[string]attr.Value) string {
+			return services.ProjectToken(projectId)
+		},
+	}
+}
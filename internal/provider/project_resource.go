@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-azdo/services"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/operations"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectResource{}
+var _ resource.ResourceWithImportState = &ProjectResource{}
+
+// Version control systems of the projects.
+var projectVersionControls = []string{"Git", "Tfvc"}
+
+func NewProjectResource() resource.Resource {
+	return &ProjectResource{}
+}
+
+// ProjectResource defines the resource implementation.
+type ProjectResource struct {
+	client           *core.ClientImpl
+	operationsClient *operations.ClientImpl
+}
+
+// ProjectResourceModel describes the resource data model.
+type ProjectResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	Visibility        types.String `tfsdk:"visibility"`
+	VersionControl    types.String `tfsdk:"version_control"`
+	ProcessTemplate   types.String `tfsdk:"process_template"`
+	ProcessTemplateId types.String `tfsdk:"process_template_id"`
+}
+
+func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Project resource. Existing projects are imported by ID or name",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the project",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the project",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The description of the project",
+			},
+			"visibility": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(core.ProjectVisibilityValues.Private)),
+				MarkdownDescription: "The visibility of the project, `private` or `public`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(core.ProjectVisibilityValues.Private), string(core.ProjectVisibilityValues.Public)),
+				},
+			},
+			"version_control": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Git"),
+				MarkdownDescription: "The version control system of the project, `Git` or `Tfvc`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(projectVersionControls...),
+				},
+			},
+			"process_template": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the process template of the project, for example `Agile` or `Scrum`. The default process of the collection is used when not set",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"process_template_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the process template of the project",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.CoreClient
+	r.operationsClient = clients.OperationsClient
+}
+
+func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectService := services.NewProjectService(r.client, r.operationsClient)
+
+	// An unknown process template selects the default process of the collection.
+	process, err := projectService.GetProcess(ctx, data.ProcessTemplate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	name := data.Name.ValueString()
+	description := data.Description.ValueString()
+	visibility := core.ProjectVisibility(data.Visibility.ValueString())
+	capabilities := map[string]map[string]string{
+		services.VersionControlCapability: {
+			"sourceControlType": data.VersionControl.ValueString(),
+		},
+		services.ProcessTemplateCapability: {
+			"templateTypeId": process.Id.String(),
+		},
+	}
+
+	project, err := projectService.CreateProject(ctx, &core.TeamProject{
+		Name:         &name,
+		Description:  &description,
+		Visibility:   &visibility,
+		Capabilities: &capabilities,
+	})
+	if err != nil {
+		// Save the ID of a project that was created but did not complete, so that it is tainted instead of orphaned.
+		if project.Id != nil {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), project.Id.String())...)
+		}
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Id = types.StringValue(project.Id.String())
+	if data.ProcessTemplate.IsUnknown() {
+		data.ProcessTemplate = types.StringPointerValue(process.Name)
+	}
+	data.ProcessTemplateId = types.StringValue(process.Id.String())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectService := services.NewProjectService(r.client, r.operationsClient)
+
+	project, err := projectService.GetProject(ctx, data.Id.ValueString())
+	// Projects whose creation did not complete are kept, so that the tainted project is deleted.
+	if services.IsNotFound(err) || (err == nil && project.State != nil && (*project.State == core.ProjectStateValues.Deleting || *project.State == core.ProjectStateValues.Deleted)) {
+		tflog.Info(ctx, fmt.Sprintf("Project %s no longer exists, removing it from state", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(project.Id.String())
+	data.Name = types.StringPointerValue(project.Name)
+	data.Description = types.StringValue("")
+	if project.Description != nil {
+		data.Description = types.StringValue(*project.Description)
+	}
+	if project.Visibility != nil {
+		data.Visibility = types.StringValue(string(*project.Visibility))
+	}
+	if project.Capabilities != nil {
+		// Names differing only in case from the configured ones are kept as configured.
+		if versionControl, ok := (*project.Capabilities)[services.VersionControlCapability]["sourceControlType"]; ok && !strings.EqualFold(versionControl, data.VersionControl.ValueString()) {
+			data.VersionControl = types.StringValue(versionControl)
+		}
+		if templateName, ok := (*project.Capabilities)[services.ProcessTemplateCapability]["templateName"]; ok && !strings.EqualFold(templateName, data.ProcessTemplate.ValueString()) {
+			data.ProcessTemplate = types.StringValue(templateName)
+		}
+		if templateId, ok := (*project.Capabilities)[services.ProcessTemplateCapability]["templateTypeId"]; ok {
+			data.ProcessTemplateId = types.StringValue(templateId)
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ProjectResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId, err := uuid.Parse(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	projectService := services.NewProjectService(r.client, r.operationsClient)
+
+	description := data.Description.ValueString()
+	visibility := core.ProjectVisibility(data.Visibility.ValueString())
+	update := core.TeamProject{
+		Description: &description,
+		Visibility:  &visibility,
+	}
+	// The name is only sent when the project is renamed.
+	if !data.Name.Equal(state.Name) {
+		name := data.Name.ValueString()
+		update.Name = &name
+	}
+
+	err = projectService.UpdateProject(ctx, projectId, &update)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId, err := uuid.Parse(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	projectService := services.NewProjectService(r.client, r.operationsClient)
+
+	err = projectService.DeleteProject(ctx, projectId)
+	if err != nil && !services.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+}
+
+func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Projects are imported by ID or name, Read replaces the name by the ID.
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
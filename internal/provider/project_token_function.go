@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ProjectTokenFunction{}
+
+func NewProjectTokenFunction() function.Function {
+	return &ProjectTokenFunction{}
+}
+
+// ProjectTokenFunction defines the function implementation.
+type ProjectTokenFunction struct{}
+
+func (f *ProjectTokenFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "project_token"
+}
+
+func (f *ProjectTokenFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Security token of a project",
+		MarkdownDescription: "Returns the security token of a project in the `Project` security namespace",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "project_id",
+				MarkdownDescription: "The ID of the project",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ProjectTokenFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var projectId string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &projectId))
+	if resp.Error != nil {
+		return
+	}
+	if projectId == "" {
+		resp.Error = function.NewArgumentFuncError(0, "project_id must not be empty")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, services.ProjectToken(projectId)))
+}
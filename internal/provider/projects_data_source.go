@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/operations"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectsDataSource{}
+
+// States of the projects accepted by the projects data source.
+var projectStates = []string{
+	string(core.ProjectStateValues.WellFormed),
+	string(core.ProjectStateValues.New),
+	string(core.ProjectStateValues.CreatePending),
+	string(core.ProjectStateValues.Deleting),
+	string(core.ProjectStateValues.Deleted),
+	string(core.ProjectStateValues.All),
+}
+
+func NewProjectsDataSource() datasource.DataSource {
+	log.Println("NewProjectsDataSource")
+	return &ProjectsDataSource{}
+}
+
+// ProjectsDataSource defines the data source implementation.
+type ProjectsDataSource struct {
+	client           *core.ClientImpl
+	operationsClient *operations.ClientImpl
+}
+
+// ProjectsDataSourceModel describes the data source data model.
+type ProjectsDataSourceModel struct {
+	State    types.String   `tfsdk:"state"`
+	Projects []ProjectModel `tfsdk:"projects"`
+}
+
+type ProjectModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Visibility  types.String `tfsdk:"visibility"`
+	State       types.String `tfsdk:"state"`
+}
+
+func (d *ProjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (d *ProjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Projects of the collection",
+		Attributes: map[string]schema.Attribute{
+			"state": schema.StringAttribute{
+				Optional:    true,
+				Description: "The state of the projects to list, for example wellFormed, deleting or all. Only wellFormed projects are listed when not set",
+				Validators: []validator.String{
+					stringvalidator.OneOf(projectStates...),
+				},
+			},
+			"projects": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the project",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the project",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description of the project",
+						},
+						"visibility": schema.StringAttribute{
+							Computed:    true,
+							Description: "The visibility of the project (private or public)",
+						},
+						"state": schema.StringAttribute{
+							Computed:    true,
+							Description: "The state of the project, for example wellFormed",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	log.Println("Configure ProjectsDataSource")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.CoreClient
+	d.operationsClient = clients.OperationsClient
+}
+
+func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectService := services.NewProjectService(d.client, d.operationsClient)
+
+	state := core.ProjectStateValues.WellFormed
+	if !data.State.IsNull() {
+		state = core.ProjectState(data.State.ValueString())
+	}
+
+	projects, err := projectService.GetProjects(ctx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	data.Projects = []ProjectModel{}
+	for _, project := range projects {
+		data.Projects = append(data.Projects, newProjectModel(project))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func newProjectModel(project core.TeamProjectReference) ProjectModel {
+	projectModel := ProjectModel{
+		Id:          types.StringValue(project.Id.String()),
+		Name:        types.StringPointerValue(project.Name),
+		Description: types.StringPointerValue(project.Description),
+	}
+	if project.Visibility != nil {
+		projectModel.Visibility = types.StringValue(string(*project.Visibility))
+	}
+	if project.State != nil {
+		projectModel.State = types.StringValue(string(*project.State))
+	}
+	return projectModel
+}
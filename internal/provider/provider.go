@@ -16,11 +16,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/microsoft/azure-devops-go-api/azuredevops"
-	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
 )
 
 // Ensure ScaffoldingProvider satisfies various provider interfaces.
 var _ provider.Provider = &AzdoProvider{}
+var _ provider.ProviderWithFunctions = &AzdoProvider{}
 
 // AzdoProvider defines the provider implementation.
 type AzdoProvider struct {
@@ -99,21 +99,53 @@ func (p *AzdoProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 
 	ctx = context.Background()
 
-	// Create a client to interact with the Core area
-	coreClient, err := identity.NewClient(ctx, connection)
+	clients, err := NewAzdoClients(ctx, connection)
 
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	resp.DataSourceData = coreClient
-	resp.ResourceData = coreClient
+	resp.DataSourceData = clients
+	resp.ResourceData = clients
 
 }
 
 func (p *AzdoProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewGroupMembershipResource,
+		NewGroupResource,
+		NewGitPermissionsResource,
+		NewAccessControlEntryResource,
+		NewBranchPolicyMinReviewersResource,
+		NewBranchPolicyBuildValidationResource,
+		NewBranchPolicyRequiredReviewersResource,
+		NewBranchPolicyCommentResolutionResource,
+		NewBranchPolicyWorkItemLinkingResource,
+		NewBranchPolicyMergeTypesResource,
+		NewPolicyConfigurationResource,
+		NewAccessControlInheritanceResource,
+		NewAccessControlListResource,
+		NewProjectPermissionsResource,
+		NewBuildPermissionsResource,
+		NewAreaPermissionsResource,
+		NewIterationPermissionsResource,
+		NewServiceEndpointRoleAssignmentResource,
+		NewLibraryRoleAssignmentResource,
+		NewAgentPoolRoleAssignmentResource,
+		NewAgentQueueRoleAssignmentResource,
+		NewEnvironmentRoleAssignmentResource,
+		NewEnvironmentCheckApprovalResource,
+		NewEnvironmentCheckBusinessHoursResource,
+		NewProjectResource,
+		NewGitRepositoryResource,
+		NewTeamResource,
+		NewTeamMembersResource,
+		NewTeamAdministratorsResource,
+		NewTeamSettingsResource,
+		NewTeamFieldValuesResource,
+		NewTeamIterationResource,
+		NewAreaPathResource,
+		NewIterationPathResource,
 	}
 }
 
@@ -121,11 +153,30 @@ func (p *AzdoProvider) DataSources(ctx context.Context) []func() datasource.Data
 	return []func() datasource.DataSource{
 		NewIdentitiesDataSource,
 		NewIdentityDataSource,
+		NewGroupMembersDataSource,
+		NewGroupMembershipsOfDataSource,
+		NewIdentityScopeDataSource,
+		NewIdentityScopesDataSource,
+		NewClientConfigDataSource,
+		NewSecurityNamespaceDataSource,
+		NewEffectivePermissionsDataSource,
+		NewPolicyTypesDataSource,
+		NewProjectDataSource,
+		NewProjectsDataSource,
+		NewGitRepositoryDataSource,
+		NewClassificationNodesDataSource,
 	}
 }
 
 func (p *AzdoProvider) Functions(ctx context.Context) []func() function.Function {
-	return nil
+	return []func() function.Function{
+		NewProjectTokenFunction,
+		NewGitRepositoryTokenFunction,
+		NewGitBranchTokenFunction,
+		NewBuildFolderTokenFunction,
+		NewBuildDefinitionTokenFunction,
+		NewClassificationNodeTokenFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Roles of the service endpoints and of the library.
+var distributedTaskRoles = []string{"Reader", "User", "Administrator", "Creator"}
+
+// Roles of the agent pools and queues.
+var (
+	agentPoolRoles  = []string{"Reader", "User", "Administrator"}
+	agentQueueRoles = []string{"Reader", "User", "Creator", "Administrator"}
+)
+
+// Roles of the environments.
+var environmentRoles = []string{"Reader", "User", "Administrator"}
+
+// roleAssignmentId identifies the role assignment of an identity on a resource.
+func roleAssignmentId(scope string, resourceId string, identityId string) string {
+	return scope + "/" + resourceId + "/" + identityId
+}
+
+// roleAssignmentAttributes returns the attributes shared by the role
+// assignment resources.
+func roleAssignmentAttributes(roles []string, roleDescription string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The scope, resource and identity of the role assignment",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"principal": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The ID, descriptor, subject descriptor or name of the identity to assign the role to, see `azdo_identity`",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"identity_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the identity the role is assigned to",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"role": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: roleDescription,
+			Validators: []validator.String{
+				stringvalidator.OneOf(roles...),
+			},
+		},
+	}
+}
@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RoleAssignmentResource{}
+var _ resource.ResourceWithImportState = &RoleAssignmentResource{}
+
+// RoleAssignmentResource defines the resource implementation shared by the
+// role assignment resources, which only differ in their scope and in the
+// attributes of the resource the role is assigned on.
+type RoleAssignmentResource struct {
+	client         *azuredevops.Client
+	identityClient *identity.ClientImpl
+	// name is the prefix of the resource type name, for example agent_pool.
+	name            string
+	description     string
+	roles           []string
+	roleDescription string
+	// resourceAttributes are the attributes of the resource the role is assigned on.
+	resourceAttributes map[string]schema.Attribute
+	// importAttributes are the names of the resource attributes in the order
+	// they appear in the import ID, which ends with the identity ID.
+	importAttributes []string
+	// scope returns the scope and the resource ID of the role assignment from
+	// the values of the resource attributes.
+	scope func(values map[string]attr.Value) (string, string)
+}
+
+// RoleAssignmentResourceModel describes the resource data model, next to the resource attributes.
+type RoleAssignmentResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Principal  types.String `tfsdk:"principal"`
+	IdentityId types.String `tfsdk:"identity_id"`
+	Role       types.String `tfsdk:"role"`
+}
+
+func (r *RoleAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.name + "_role_assignment"
+}
+
+func (r *RoleAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := roleAssignmentAttributes(r.roles, r.roleDescription)
+	for name, attribute := range r.resourceAttributes {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: r.description + ". " + r.importDescription(),
+		Attributes:          attributes,
+	}
+}
+
+func (r *RoleAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.SecurityRolesClient
+	r.identityClient = clients.IdentityClient
+}
+
+func (r *RoleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RoleAssignmentResourceModel
+
+	// Read Terraform plan data into the model
+	values, diags := r.getModel(ctx, req.Plan, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityService := services.NewIdentityService(r.identityClient)
+	securityRoleService := services.NewSecurityRoleService(r.client)
+
+	identityId, err := identityService.ResolveIdentityId(ctx, data.Principal.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	scope, resourceId := r.scope(values)
+	err = securityRoleService.SetRoleAssignment(ctx, scope, resourceId, identityId, data.Role.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.IdentityId = types.StringValue(identityId)
+	data.Id = types.StringValue(roleAssignmentId(scope, resourceId, identityId))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(r.setModel(ctx, &resp.State, &data, values)...)
+}
+
+func (r *RoleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RoleAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	values, diags := r.getModel(ctx, req.State, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityRoleService := services.NewSecurityRoleService(r.client)
+
+	scope, resourceId := r.scope(values)
+	assignment, err := securityRoleService.GetRoleAssignment(ctx, scope, resourceId, data.IdentityId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	if assignment == nil || assignment.Role == nil {
+		tflog.Info(ctx, fmt.Sprintf("Role assignment %s no longer exists, removing it from state", roleAssignmentId(scope, resourceId, data.IdentityId.ValueString())))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.Id = types.StringValue(roleAssignmentId(scope, resourceId, data.IdentityId.ValueString()))
+	data.Role = types.StringPointerValue(assignment.Role.Name)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(r.setModel(ctx, &resp.State, &data, values)...)
+}
+
+func (r *RoleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RoleAssignmentResourceModel
+
+	// Read Terraform plan data into the model
+	values, diags := r.getModel(ctx, req.Plan, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityRoleService := services.NewSecurityRoleService(r.client)
+
+	scope, resourceId := r.scope(values)
+	err := securityRoleService.SetRoleAssignment(ctx, scope, resourceId, data.IdentityId.ValueString(), data.Role.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(r.setModel(ctx, &resp.State, &data, values)...)
+}
+
+func (r *RoleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RoleAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	values, diags := r.getModel(ctx, req.State, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityRoleService := services.NewSecurityRoleService(r.client)
+
+	scope, resourceId := r.scope(values)
+	err := securityRoleService.RemoveRoleAssignment(ctx, scope, resourceId, data.IdentityId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+}
+
+func (r *RoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Role assignments are imported as the resource attributes followed by the identity ID.
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != len(r.importAttributes)+1 || parts[len(parts)-1] == "" {
+		resp.Diagnostics.AddError("Error", fmt.Sprintf("invalid import ID %s, expected %s", req.ID, r.importId()))
+		return
+	}
+
+	for i, name := range r.importAttributes {
+		// Optional attributes are left out of the state when their part is empty.
+		if parts[i] == "" {
+			if r.resourceAttributes[name].IsRequired() {
+				resp.Diagnostics.AddError("Error", fmt.Sprintf("invalid import ID %s, expected %s", req.ID, r.importId()))
+				return
+			}
+			continue
+		}
+
+		var value interface{} = parts[i]
+		if _, ok := r.resourceAttributes[name].(schema.Int64Attribute); ok {
+			number, err := strconv.ParseInt(parts[i], 10, 64)
+			if err != nil {
+				resp.Diagnostics.AddError("Error", fmt.Sprintf("invalid import ID %s, expected %s", req.ID, r.importId()))
+				return
+			}
+			value = number
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), value)...)
+	}
+
+	identityId := parts[len(parts)-1]
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identity_id"), identityId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal"), identityId)...)
+}
+
+// getModel reads the plan or state into the model and returns the values of the resource attributes.
+func (r *RoleAssignmentResource) getModel(ctx context.Context, source interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}, data *RoleAssignmentResourceModel) (map[string]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	diags.Append(source.GetAttribute(ctx, path.Root("id"), &data.Id)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("principal"), &data.Principal)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("identity_id"), &data.IdentityId)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("role"), &data.Role)...)
+
+	values := make(map[string]attr.Value, len(r.resourceAttributes))
+	for name := range r.resourceAttributes {
+		var value attr.Value
+		diags.Append(source.GetAttribute(ctx, path.Root(name), &value)...)
+		values[name] = value
+	}
+	return values, diags
+}
+
+// setModel saves the model and the values of the resource attributes into the state.
+func (r *RoleAssignmentResource) setModel(ctx context.Context, state *tfsdk.State, data *RoleAssignmentResourceModel, values map[string]attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), data.Id)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("principal"), data.Principal)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("identity_id"), data.IdentityId)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("role"), data.Role)...)
+
+	for name, value := range values {
+		diags.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+	}
+	return diags
+}
+
+// importId returns the format of the import ID, like `<project ID>/<queue ID>/<identity ID>`.
+func (r *RoleAssignmentResource) importId() string {
+	var parts []string
+	for _, name := range r.importAttributes {
+		parts = append(parts, "<"+roleAssignmentAttributeLabel(name)+">")
+	}
+	return strings.Join(append(parts, "<identity ID>"), "/")
+}
+
+// importDescription documents the import ID of the resource.
+func (r *RoleAssignmentResource) importDescription() string {
+	description := fmt.Sprintf("Existing assignments are imported as `%s`", r.importId())
+	for _, name := range r.importAttributes {
+		if !r.resourceAttributes[name].IsRequired() {
+			description += fmt.Sprintf(", with an empty `<%s>` when `%s` is not set", roleAssignmentAttributeLabel(name), name)
+		}
+	}
+	return description + ", the `principal` of an imported assignment is the ID of the identity"
+}
+
+// roleAssignmentAttributeLabel turns an attribute name like queue_id into queue ID.
+func roleAssignmentAttributeLabel(name string) string {
+	return strings.ReplaceAll(strings.TrimSuffix(name, "_id"), "_", " ") + " ID"
+}
+
+// int64AttributeString formats the value of an Int64 attribute, it is empty when the value is null.
+func int64AttributeString(value attr.Value) string {
+	number := value.(types.Int64)
+	if number.IsNull() {
+		return ""
+	}
+	return strconv.FormatInt(number.ValueInt64(), 10)
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-azdo/services"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SecurityNamespaceDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &SecurityNamespaceDataSource{}
+
+func NewSecurityNamespaceDataSource() datasource.DataSource {
+	log.Println("NewSecurityNamespaceDataSource")
+	return &SecurityNamespaceDataSource{}
+}
+
+// SecurityNamespaceDataSource defines the data source implementation.
+type SecurityNamespaceDataSource struct {
+	client *security.ClientImpl
+}
+
+// SecurityNamespaceDataSourceModel describes the data source data model.
+type SecurityNamespaceDataSourceModel struct {
+	Id              types.String                   `tfsdk:"id"`
+	Name            types.String                   `tfsdk:"name"`
+	DisplayName     types.String                   `tfsdk:"display_name"`
+	Separator       types.String                   `tfsdk:"separator"`
+	ElementLength   types.Int64                    `tfsdk:"element_length"`
+	ReadPermission  types.Int64                    `tfsdk:"read_permission"`
+	WritePermission types.Int64                    `tfsdk:"write_permission"`
+	Actions         []SecurityNamespaceActionModel `tfsdk:"actions"`
+}
+
+type SecurityNamespaceActionModel struct {
+	Name        types.String `tfsdk:"name"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Bit         types.Int64  `tfsdk:"bit"`
+}
+
+func (d *SecurityNamespaceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_namespace"
+}
+
+func (d *SecurityNamespaceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Security namespace. Lists the actions of the namespace and their permission bits",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the security namespace",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the security namespace, for example Git Repositories, Build or CSS",
+			},
+			"display_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The display name of the security namespace",
+			},
+			"separator": schema.StringAttribute{
+				Computed:    true,
+				Description: "The character separating the elements of a token, empty when tokens are not hierarchical",
+			},
+			"element_length": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The length of the elements of a token, -1 when tokens are not split on length",
+			},
+			"read_permission": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The permission bits needed to read the security data of the namespace",
+			},
+			"write_permission": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The permission bits needed to modify the security data of the namespace",
+			},
+			"actions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The actions secured by the namespace",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the action, as used in the permission resources",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The display name of the action",
+						},
+						"bit": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The permission bit of the action",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SecurityNamespaceDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("name"),
+			path.MatchRoot("id"),
+		),
+	}
+}
+
+func (d *SecurityNamespaceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	log.Println("Configure SecurityNamespaceDataSource")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.SecurityClient
+}
+
+func (d *SecurityNamespaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SecurityNamespaceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityService := services.NewSecurityService(d.client)
+
+	var namespace *security.SecurityNamespaceDescription
+	if !data.Id.IsNull() {
+		namespaceId, err := uuid.Parse(data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid security namespace ID", err.Error())
+			return
+		}
+		namespace, err = securityService.GetNamespace(ctx, namespaceId)
+		if err != nil {
+			resp.Diagnostics.AddError("Error", err.Error())
+			return
+		}
+	} else {
+		var err error
+		namespace, err = securityService.GetNamespaceByName(ctx, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error", err.Error())
+			return
+		}
+		data.Id = types.StringValue(namespace.NamespaceId.String())
+	}
+	// Keep the configured name as is, namespace names are matched case-insensitively.
+	if data.Name.IsNull() {
+		data.Name = types.StringPointerValue(namespace.Name)
+	}
+
+	data.DisplayName = types.StringPointerValue(namespace.DisplayName)
+	// The API returns the null character when tokens are not hierarchical.
+	data.Separator = types.StringValue("")
+	if namespace.SeparatorValue != nil && *namespace.SeparatorValue != "\x00" {
+		data.Separator = types.StringValue(*namespace.SeparatorValue)
+	}
+	data.ElementLength = int64PointerValue(namespace.ElementLength)
+	data.ReadPermission = int64PointerValue(namespace.ReadPermission)
+	data.WritePermission = int64PointerValue(namespace.WritePermission)
+
+	data.Actions = []SecurityNamespaceActionModel{}
+	if namespace.Actions != nil {
+		for _, action := range *namespace.Actions {
+			data.Actions = append(data.Actions, SecurityNamespaceActionModel{
+				Name:        types.StringPointerValue(action.Name),
+				DisplayName: types.StringPointerValue(action.DisplayName),
+				Bit:         int64PointerValue(action.Bit),
+			})
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func int64PointerValue(value *int) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
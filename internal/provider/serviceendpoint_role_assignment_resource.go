@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewServiceEndpointRoleAssignmentResource() resource.Resource {
+	return &RoleAssignmentResource{
+		name:            "serviceendpoint",
+		description:     "Azdo Service endpoint role assignment resource",
+		roles:           distributedTaskRoles,
+		roleDescription: "The name of the role, `Reader`, `User`, `Administrator` or `Creator`. `Creator` can only be assigned for all service endpoints of the project",
+		resourceAttributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the project",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"serviceendpoint_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the service endpoint, the role applies to all service endpoints of the project when not set",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		importAttributes: []string{"project_id", "serviceendpoint_id"},
+		scope: func(values map[string]attr.Value) (string, string) {
+			projectId := values["project_id"].(types.String)
+			endpointId := values["serviceendpoint_id"].(types.String)
+			return services.ServiceEndpointRoleScope, services.ServiceEndpointRoleResourceId(projectId.ValueString(), endpointId.ValueString())
+		},
+	}
+}
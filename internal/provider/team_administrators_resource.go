@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TeamAdministratorsResource{}
+var _ resource.ResourceWithImportState = &TeamAdministratorsResource{}
+
+func NewTeamAdministratorsResource() resource.Resource {
+	return &TeamAdministratorsResource{}
+}
+
+// TeamAdministratorsResource defines the resource implementation.
+type TeamAdministratorsResource struct {
+	client         *identity.ClientImpl
+	securityClient *security.ClientImpl
+}
+
+// TeamAdministratorsResourceModel describes the resource data model.
+type TeamAdministratorsResourceModel struct {
+	Id             types.String   `tfsdk:"id"`
+	ProjectId      types.String   `tfsdk:"project_id"`
+	TeamId         types.String   `tfsdk:"team_id"`
+	Administrators []types.String `tfsdk:"administrators"`
+}
+
+func (r *TeamAdministratorsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_administrators"
+}
+
+func (r *TeamAdministratorsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Team administrators resource, manages all administrators of a team: administrators that are not configured are removed. Administrators may manage the members and the settings of the team. Existing administrators are imported as `<project ID>/<team ID>`",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The project and team of the administrators",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the project",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the team",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"administrators": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "The IDs, descriptors, subject descriptors or names of the users and groups that administer the team, for example `user@example.com` or `[Project]\\Project Administrators`",
+			},
+		},
+	}
+}
+
+func (r *TeamAdministratorsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.IdentityClient
+	r.securityClient = clients.SecurityClient
+}
+
+func (r *TeamAdministratorsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamAdministratorsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityService := services.NewIdentityService(r.client)
+
+	team, err := identityService.GetIdentityById(ctx, data.TeamId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	err = applyTeamIdentities(ctx, identityService, r.operations(identityService, data.ProjectId.ValueString()), team, data.Administrators)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Id = types.StringValue(data.ProjectId.ValueString() + "/" + data.TeamId.ValueString())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamAdministratorsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamAdministratorsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityService := services.NewIdentityService(r.client)
+
+	team, err := identityService.GetIdentityById(ctx, data.TeamId.ValueString())
+	if services.IsNotFound(err) {
+		tflog.Info(ctx, fmt.Sprintf("Team %s no longer exists, removing its administrators from state", data.TeamId.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	data.Administrators, err = readTeamIdentities(ctx, identityService, r.operations(identityService, data.ProjectId.ValueString()), team, data.Administrators)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamAdministratorsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TeamAdministratorsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityService := services.NewIdentityService(r.client)
+
+	team, err := identityService.GetIdentityById(ctx, data.TeamId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	err = applyTeamIdentities(ctx, identityService, r.operations(identityService, data.ProjectId.ValueString()), team, data.Administrators)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamAdministratorsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamAdministratorsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityService := services.NewIdentityService(r.client)
+
+	team, err := identityService.GetIdentityById(ctx, data.TeamId.ValueString())
+	if services.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	err = removeTeamIdentities(ctx, identityService, r.operations(identityService, data.ProjectId.ValueString()), team, data.Administrators)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+}
+
+func (r *TeamAdministratorsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Administrators are imported as <project ID>/<team ID>, Read adds every current administrator.
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Error", fmt.Sprintf("invalid import ID %s, expected <project ID>/<team ID>", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("administrators"), []string{})...)
+}
+
+// operations manages the entries of the administrators in the Identity
+// namespace on the token of the team.
+func (r *TeamAdministratorsResource) operations(identityService *services.IdentityService, projectId string) teamIdentityOperations {
+	securityService := services.NewSecurityService(r.securityClient)
+	return teamIdentityOperations{
+		list: func(ctx context.Context, team *identity.Identity) (*[]identity.Identity, error) {
+			acl, err := securityService.GetAccessControlList(ctx, services.IdentityNamespaceId, services.TeamToken(projectId, team.Id.String()))
+			if err != nil {
+				return &[]identity.Identity{}, err
+			}
+
+			var descriptors []string
+			for descriptor, ace := range *acl.AcesDictionary {
+				if ace.Allow != nil && *ace.Allow&services.TeamAdministratorBits == services.TeamAdministratorBits {
+					descriptors = append(descriptors, descriptor)
+				}
+			}
+			if len(descriptors) == 0 {
+				return &[]identity.Identity{}, nil
+			}
+			descriptorsCombined := strings.Join(descriptors, ",")
+			return identityService.GetIdentitiesByDescriptor(ctx, &descriptorsCombined)
+		},
+		add: func(ctx context.Context, team *identity.Identity, member *identity.Identity) error {
+			allow, deny := services.TeamAdministratorBits, 0
+			return securityService.SetAccessControlEntry(ctx, services.IdentityNamespaceId, services.TeamToken(projectId, team.Id.String()), &security.AccessControlEntry{
+				Descriptor: member.Descriptor,
+				Allow:      &allow,
+				Deny:       &deny,
+			})
+		},
+		remove: func(ctx context.Context, team *identity.Identity, member *identity.Identity) error {
+			return securityService.RemoveAccessControlEntry(ctx, services.IdentityNamespaceId, services.TeamToken(projectId, team.Id.String()), *member.Descriptor)
+		},
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/work"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TeamFieldValuesResource{}
+var _ resource.ResourceWithImportState = &TeamFieldValuesResource{}
+
+func NewTeamFieldValuesResource() resource.Resource {
+	return &TeamFieldValuesResource{}
+}
+
+// TeamFieldValuesResource defines the resource implementation.
+type TeamFieldValuesResource struct {
+	client *work.ClientImpl
+}
+
+// TeamFieldValuesResourceModel describes the resource data model.
+type TeamFieldValuesResourceModel struct {
+	Id           types.String          `tfsdk:"id"`
+	ProjectId    types.String          `tfsdk:"project_id"`
+	TeamId       types.String          `tfsdk:"team_id"`
+	Field        types.String          `tfsdk:"field"`
+	DefaultValue types.String          `tfsdk:"default_value"`
+	Values       []TeamFieldValueModel `tfsdk:"values"`
+}
+
+type TeamFieldValueModel struct {
+	Value           types.String `tfsdk:"value"`
+	IncludeChildren types.Bool   `tfsdk:"include_children"`
+}
+
+func (r *TeamFieldValuesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_field_values"
+}
+
+func (r *TeamFieldValuesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Team field values resource, sets the values of the team field, usually the area paths, whose work items belong to a team. Deleting the resource leaves the field values of the team unchanged. Existing field values are imported as `<project ID>/<team ID>`",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The project and team of the field values",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the project",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the team",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"field": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The reference name of the team field, for example `System.AreaPath`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_value": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The default value of the team field for new work items, for example the area path `Project\\Team`",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"values": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The values of the team field whose work items belong to the team",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The value, for example the area path `Project\\Team`",
+						},
+						"include_children": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Whether the work items of the child area paths belong to the team as well",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamFieldValuesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.WorkClient
+}
+
+func (r *TeamFieldValuesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamFieldValuesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.update(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Id = types.StringValue(data.ProjectId.ValueString() + "/" + data.TeamId.ValueString())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamFieldValuesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamFieldValuesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workService := services.NewWorkService(r.client)
+
+	fieldValues, err := workService.GetTeamFieldValues(ctx, data.ProjectId.ValueString(), data.TeamId.ValueString())
+	if services.IsNotFound(err) {
+		tflog.Info(ctx, fmt.Sprintf("Team %s no longer exists, removing its field values from state", data.TeamId.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	if fieldValues.Field != nil {
+		data.Field = types.StringPointerValue(fieldValues.Field.ReferenceName)
+	}
+	data.DefaultValue = types.StringPointerValue(fieldValues.DefaultValue)
+	data.Values = []TeamFieldValueModel{}
+	if fieldValues.Values != nil {
+		for _, value := range *fieldValues.Values {
+			data.Values = append(data.Values, TeamFieldValueModel{
+				Value:           types.StringPointerValue(value.Value),
+				IncludeChildren: types.BoolValue(value.IncludeChildren != nil && *value.IncludeChildren),
+			})
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamFieldValuesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TeamFieldValuesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.update(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamFieldValuesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A team always has field values, they are left unchanged.
+}
+
+func (r *TeamFieldValuesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Field values are imported as <project ID>/<team ID>.
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Error", fmt.Sprintf("invalid import ID %s, expected <project ID>/<team ID>", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), parts[1])...)
+}
+
+// update replaces the field values of the team and sets the team field.
+func (r *TeamFieldValuesResource) update(ctx context.Context, data *TeamFieldValuesResourceModel) error {
+	defaultValue := data.DefaultValue.ValueString()
+	values := []work.TeamFieldValue{}
+	for _, value := range data.Values {
+		values = append(values, work.TeamFieldValue{
+			Value:           value.Value.ValueStringPointer(),
+			IncludeChildren: value.IncludeChildren.ValueBoolPointer(),
+		})
+	}
+
+	workService := services.NewWorkService(r.client)
+
+	fieldValues, err := workService.UpdateTeamFieldValues(ctx, data.ProjectId.ValueString(), data.TeamId.ValueString(), &work.TeamFieldValuesPatch{
+		DefaultValue: &defaultValue,
+		Values:       &values,
+	})
+	if err != nil {
+		return err
+	}
+	data.Field = types.StringNull()
+	if fieldValues.Field != nil {
+		data.Field = types.StringPointerValue(fieldValues.Field.ReferenceName)
+	}
+	return nil
+}
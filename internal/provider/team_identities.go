@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-azdo/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+)
+
+// teamIdentityOperations lists, adds and removes the identities managed by
+// the team members and team administrators resources.
+type teamIdentityOperations struct {
+	list   func(ctx context.Context, team *identity.Identity) (*[]identity.Identity, error)
+	add    func(ctx context.Context, team *identity.Identity, member *identity.Identity) error
+	remove func(ctx context.Context, team *identity.Identity, member *identity.Identity) error
+}
+
+// resolveTeamIdentities resolves the configured IDs, descriptors or names to
+// identities, keyed by identity ID.
+func resolveTeamIdentities(ctx context.Context, identityService *services.IdentityService, values []types.String) (map[string]*identity.Identity, error) {
+	resolved := map[string]*identity.Identity{}
+	for _, value := range values {
+		foundIdentity, err := identityService.ResolveIdentity(ctx, value.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		resolved[foundIdentity.Id.String()] = foundIdentity
+	}
+	return resolved, nil
+}
+
+// applyTeamIdentities adds the configured identities that are missing and
+// removes the identities that are not configured.
+func applyTeamIdentities(ctx context.Context, identityService *services.IdentityService, operations teamIdentityOperations, team *identity.Identity, values []types.String) error {
+	configured, err := resolveTeamIdentities(ctx, identityService, values)
+	if err != nil {
+		return err
+	}
+	current, err := operations.list(ctx, team)
+	if err != nil {
+		return err
+	}
+
+	currentIds := map[string]bool{}
+	for _, member := range *current {
+		currentIds[member.Id.String()] = true
+		if _, ok := configured[member.Id.String()]; !ok {
+			err = operations.remove(ctx, team, &member)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for id, member := range configured {
+		if !currentIds[id] {
+			err = operations.add(ctx, team, member)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// readTeamIdentities returns the configured values of the identities that are
+// still present, followed by the display names of the identities that were
+// added outside of Terraform.
+func readTeamIdentities(ctx context.Context, identityService *services.IdentityService, operations teamIdentityOperations, team *identity.Identity, values []types.String) ([]types.String, error) {
+	current, err := operations.list(ctx, team)
+	if err != nil {
+		return nil, err
+	}
+	currentIds := map[string]*identity.Identity{}
+	for i := range *current {
+		currentIds[(*current)[i].Id.String()] = &(*current)[i]
+	}
+
+	result := []types.String{}
+	for _, value := range values {
+		foundIdentity, err := identityService.ResolveIdentity(ctx, value.ValueString())
+		if services.IsNotFound(err) {
+			// An identity that no longer exists is no longer present either.
+			tflog.Info(ctx, err.Error())
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := currentIds[foundIdentity.Id.String()]; ok {
+			result = append(result, value)
+			delete(currentIds, foundIdentity.Id.String())
+		}
+	}
+	for _, member := range currentIds {
+		result = append(result, types.StringValue(services.IdentityDisplayName(member)))
+	}
+	return result, nil
+}
+
+// removeTeamIdentities removes the configured identities that are present.
+func removeTeamIdentities(ctx context.Context, identityService *services.IdentityService, operations teamIdentityOperations, team *identity.Identity, values []types.String) error {
+	configured, err := resolveTeamIdentities(ctx, identityService, values)
+	if err != nil {
+		return err
+	}
+	current, err := operations.list(ctx, team)
+	if err != nil {
+		return err
+	}
+
+	for _, member := range *current {
+		if _, ok := configured[member.Id.String()]; ok {
+			err = operations.remove(ctx, team, &member)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
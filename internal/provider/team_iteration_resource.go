@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-azdo/services"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/work"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TeamIterationResource{}
+var _ resource.ResourceWithImportState = &TeamIterationResource{}
+
+func NewTeamIterationResource() resource.Resource {
+	return &TeamIterationResource{}
+}
+
+// TeamIterationResource defines the resource implementation.
+type TeamIterationResource struct {
+	client *work.ClientImpl
+}
+
+// TeamIterationResourceModel describes the resource data model.
+type TeamIterationResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	ProjectId   types.String `tfsdk:"project_id"`
+	TeamId      types.String `tfsdk:"team_id"`
+	IterationId types.String `tfsdk:"iteration_id"`
+	Name        types.String `tfsdk:"name"`
+	Path        types.String `tfsdk:"path"`
+	StartDate   types.String `tfsdk:"start_date"`
+	FinishDate  types.String `tfsdk:"finish_date"`
+}
+
+func (r *TeamIterationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_iteration"
+}
+
+func (r *TeamIterationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Team iteration resource, subscribes a team to an iteration so it appears in the sprints of the team. Existing subscriptions are imported as `<project ID>/<team ID>/<iteration ID>`",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The project, team and iteration of the subscription",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the project",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the team",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"iteration_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the iteration node, it must be a child of the backlog iteration of the team",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the iteration",
+			},
+			"path": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The path of the iteration",
+			},
+			"start_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The start date of the iteration, formatted as `YYYY-MM-DD`",
+			},
+			"finish_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The finish date of the iteration, formatted as `YYYY-MM-DD`",
+			},
+		},
+	}
+}
+
+func (r *TeamIterationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.WorkClient
+}
+
+func (r *TeamIterationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamIterationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iterationId, err := uuid.Parse(data.IterationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	workService := services.NewWorkService(r.client)
+
+	iteration, err := workService.AddTeamIteration(ctx, data.ProjectId.ValueString(), data.TeamId.ValueString(), iterationId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.Id = types.StringValue(data.ProjectId.ValueString() + "/" + data.TeamId.ValueString() + "/" + iterationId.String())
+	data.setIteration(iteration)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamIterationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamIterationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iterationId, err := uuid.Parse(data.IterationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	workService := services.NewWorkService(r.client)
+
+	iteration, err := workService.GetTeamIteration(ctx, data.ProjectId.ValueString(), data.TeamId.ValueString(), iterationId)
+	if services.IsNotFound(err) {
+		tflog.Info(ctx, fmt.Sprintf("Iteration %s of team %s no longer exists, removing it from state", data.IterationId.ValueString(), data.TeamId.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+	data.setIteration(iteration)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamIterationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TeamIterationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attribute requires replacement, there is nothing to update.
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamIterationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamIterationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iterationId, err := uuid.Parse(data.IterationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	workService := services.NewWorkService(r.client)
+
+	err = workService.RemoveTeamIteration(ctx, data.ProjectId.ValueString(), data.TeamId.ValueString(), iterationId)
+	if err != nil && !services.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+}
+
+func (r *TeamIterationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Subscriptions are imported as <project ID>/<team ID>/<iteration ID>.
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError("Error", fmt.Sprintf("invalid import ID %s, expected <project ID>/<team ID>/<iteration ID>", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("iteration_id"), parts[2])...)
+}
+
+func (m *TeamIterationResourceModel) setIteration(iteration *work.TeamSettingsIteration) {
+	m.Name = types.StringPointerValue(iteration.Name)
+	m.Path = types.StringPointerValue(iteration.Path)
+	m.StartDate = types.StringValue("")
+	m.FinishDate = types.StringValue("")
+	if iteration.Attributes != nil {
+		if iteration.Attributes.StartDate != nil {
+			m.StartDate = types.StringValue(iteration.Attributes.StartDate.Time.Format("2006-01-02"))
+		}
+		if iteration.Attributes.FinishDate != nil {
+			m.FinishDate = types.StringValue(iteration.Attributes.FinishDate.Time.Format("2006-01-02"))
+		}
+	}
+}
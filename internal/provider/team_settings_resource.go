@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-azdo/services"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/work"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TeamSettingsResource{}
+
+// Working days of the teams, as returned by the work api.
+var teamWorkingDays = []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+
+func NewTeamSettingsResource() resource.Resource {
+	return &TeamSettingsResource{}
+}
+
+// TeamSettingsResource defines the resource implementation.
+type TeamSettingsResource struct {
+	client *work.ClientImpl
+}
+
+// TeamSettingsResourceModel describes the resource data model.
+type TeamSettingsResourceModel struct {
+	Id                    types.String          `tfsdk:"id"`
+	ProjectId             types.String          `tfsdk:"project_id"`
+	TeamId                types.String          `tfsdk:"team_id"`
+	BacklogIterationId    types.String          `tfsdk:"backlog_iteration_id"`
+	DefaultIterationId    types.String          `tfsdk:"default_iteration_id"`
+	DefaultIterationMacro types.String          `tfsdk:"default_iteration_macro"`
+	BugsBehavior          types.String          `tfsdk:"bugs_behavior"`
+	WorkingDays           []types.String        `tfsdk:"working_days"`
+	BacklogVisibilities   map[string]types.Bool `tfsdk:"backlog_visibilities"`
+}
+
+func (r *TeamSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_settings"
+}
+
+func (r *TeamSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azdo Team settings resource. Only the configured settings are managed, deleting the resource leaves the settings of the team unchanged",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The project and team of the settings",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the project",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the team",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"backlog_iteration_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The identifier of the iteration node whose children are the iterations of the backlog of the team",
+			},
+			"default_iteration_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The identifier of the iteration node new work items of the team are created in",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("default_iteration_macro")),
+				},
+			},
+			"default_iteration_macro": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The macro selecting the iteration new work items of the team are created in, for example `@currentIteration`",
+			},
+			"bugs_behavior": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "How bugs appear on the backlogs and boards of the team, `off`, `asRequirements` or `asTasks`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(work.BugsBehaviorValues.Off),
+						string(work.BugsBehaviorValues.AsRequirements),
+						string(work.BugsBehaviorValues.AsTasks),
+					),
+				},
+			},
+			"working_days": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "The working days of the team, for example `monday` or `friday`",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(teamWorkingDays...)),
+				},
+			},
+			"backlog_visibilities": schema.MapAttribute{
+				ElementType:         types.BoolType,
+				Optional:            true,
+				MarkdownDescription: "Map of backlog level category reference names, for example `Microsoft.EpicCategory`, to whether the backlog level is visible to the team. Backlog levels that are not in the map are left unchanged",
+			},
+		},
+	}
+}
+
+func (r *TeamSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AzdoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.AzdoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.WorkClient
+}
+
+func (r *TeamSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Id = types.StringValue(data.ProjectId.ValueString() + "/" + data.TeamId.ValueString())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workService := services.NewWorkService(r.client)
+
+	settings, err := workService.GetTeamSettings(ctx, data.ProjectId.ValueString(), data.TeamId.ValueString())
+	if services.IsNotFound(err) {
+		tflog.Info(ctx, fmt.Sprintf("Team %s no longer exists, removing its settings from state", data.TeamId.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error", err.Error())
+		return
+	}
+
+	// Only the configured settings are refreshed.
+	if !data.BacklogIterationId.IsNull() {
+		data.BacklogIterationId = types.StringValue("")
+		if settings.BacklogIteration != nil && settings.BacklogIteration.Id != nil {
+			data.BacklogIterationId = types.StringValue(settings.BacklogIteration.Id.String())
+		}
+	}
+	if !data.DefaultIterationId.IsNull() {
+		data.DefaultIterationId = types.StringValue("")
+		if settings.DefaultIteration != nil && settings.DefaultIteration.Id != nil {
+			data.DefaultIterationId = types.StringValue(settings.DefaultIteration.Id.String())
+		}
+	}
+	if !data.DefaultIterationMacro.IsNull() {
+		data.DefaultIterationMacro = types.StringValue("")
+		if settings.DefaultIterationMacro != nil {
+			data.DefaultIterationMacro = types.StringValue(*settings.DefaultIterationMacro)
+		}
+	}
+	if !data.BugsBehavior.IsNull() && settings.BugsBehavior != nil {
+		data.BugsBehavior = types.StringValue(string(*settings.BugsBehavior))
+	}
+	if data.WorkingDays != nil && settings.WorkingDays != nil {
+		data.WorkingDays = []types.String{}
+		for _, day := range *settings.WorkingDays {
+			data.WorkingDays = append(data.WorkingDays, types.StringValue(day))
+		}
+	}
+	if settings.BacklogVisibilities != nil {
+		for category := range data.BacklogVisibilities {
+			if visible, ok := (*settings.BacklogVisibilities)[category]; ok {
+				data.BacklogVisibilities[category] = types.BoolValue(visible)
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TeamSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings of a team cannot be deleted, they are left unchanged.
+}
+
+// update patches the configured settings of the team.
+func (r *TeamSettingsResource) update(ctx context.Context, data *TeamSettingsResourceModel) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	patch := work.TeamSettingsPatch{}
+	if !data.BacklogIterationId.IsNull() {
+		backlogIterationId, err := uuid.Parse(data.BacklogIterationId.ValueString())
+		if err != nil {
+			diagnostics.AddError("Error", fmt.Sprintf("invalid backlog iteration ID %s: %s", data.BacklogIterationId.ValueString(), err))
+			return diagnostics
+		}
+		patch.BacklogIteration = &backlogIterationId
+	}
+	if !data.DefaultIterationId.IsNull() {
+		defaultIterationId, err := uuid.Parse(data.DefaultIterationId.ValueString())
+		if err != nil {
+			diagnostics.AddError("Error", fmt.Sprintf("invalid default iteration ID %s: %s", data.DefaultIterationId.ValueString(), err))
+			return diagnostics
+		}
+		patch.DefaultIteration = &defaultIterationId
+	}
+	if !data.DefaultIterationMacro.IsNull() {
+		defaultIterationMacro := data.DefaultIterationMacro.ValueString()
+		patch.DefaultIterationMacro = &defaultIterationMacro
+	}
+	if !data.BugsBehavior.IsNull() {
+		bugsBehavior := work.BugsBehavior(data.BugsBehavior.ValueString())
+		patch.BugsBehavior = &bugsBehavior
+	}
+	if data.WorkingDays != nil {
+		workingDays := []string{}
+		for _, day := range data.WorkingDays {
+			workingDays = append(workingDays, day.ValueString())
+		}
+		patch.WorkingDays = &workingDays
+	}
+	if data.BacklogVisibilities != nil {
+		backlogVisibilities := map[string]bool{}
+		for category, visible := range data.BacklogVisibilities {
+			backlogVisibilities[category] = visible.ValueBool()
+		}
+		patch.BacklogVisibilities = &backlogVisibilities
+	}
+
+	workService := services.NewWorkService(r.client)
+
+	err := workService.UpdateTeamSettings(ctx, data.ProjectId.ValueString(), data.TeamId.ValueString(), &patch)
+	if err != nil {
+		diagnostics.AddError("Error", err.Error())
+	}
+	return diagnostics
+}
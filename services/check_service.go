@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/pipelineschecks"
+)
+
+// Well-known check types of the pipeline resources.
+var (
+	ApprovalCheckTypeId = uuid.MustParse("8c6f20a7-a545-4486-9777-f762fafe0d4d")
+	TaskCheckTypeId     = uuid.MustParse("fe1de3ee-a436-41b4-bb20-f6eb4cb879a7")
+	// BusinessHoursTaskId is the task evaluated by business hours checks.
+	BusinessHoursTaskId = uuid.MustParse("445fde2f-6c39-441c-807f-8a59ff2e075f")
+)
+
+// EnvironmentResourceType is the type of the environment resources checks are configured on.
+const EnvironmentResourceType = "environment"
+
+func NewCheckService(client *pipelineschecks.ClientImpl) *CheckService {
+	return &CheckService{client: client}
+}
+
+type CheckService struct {
+	client *pipelineschecks.ClientImpl
+}
+
+func (s *CheckService) GetCheckConfiguration(ctx context.Context, project string, configurationId int) (*pipelineschecks.CheckConfiguration, error) {
+	var response, error = s.client.GetCheckConfiguration(ctx, pipelineschecks.GetCheckConfigurationArgs{
+		Project: &project,
+		Id:      &configurationId,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to read check configuration %d from azure devops: %w", configurationId, error)
+		return &pipelineschecks.CheckConfiguration{}, error
+	}
+
+	return response, nil
+}
+
+func (s *CheckService) CreateCheckConfiguration(ctx context.Context, project string, configuration *pipelineschecks.CheckConfiguration) (*pipelineschecks.CheckConfiguration, error) {
+	tflog.Info(ctx, fmt.Sprintf("Creating check configuration of type %s in project %s", configuration.Type.Id, project))
+	var response, error = s.client.AddCheckConfiguration(ctx, pipelineschecks.AddCheckConfigurationArgs{
+		Project:       &project,
+		Configuration: configuration,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to create check configuration in azure devops: %w", error)
+		return &pipelineschecks.CheckConfiguration{}, error
+	}
+
+	return response, nil
+}
+
+func (s *CheckService) UpdateCheckConfiguration(ctx context.Context, project string, configurationId int, configuration *pipelineschecks.CheckConfiguration) (*pipelineschecks.CheckConfiguration, error) {
+	tflog.Info(ctx, fmt.Sprintf("Updating check configuration %d in project %s", configurationId, project))
+	var response, error = s.client.UpdateCheckConfiguration(ctx, pipelineschecks.UpdateCheckConfigurationArgs{
+		Project:       &project,
+		Id:            &configurationId,
+		Configuration: configuration,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to update check configuration %d in azure devops: %w", configurationId, error)
+		return &pipelineschecks.CheckConfiguration{}, error
+	}
+
+	return response, nil
+}
+
+func (s *CheckService) DeleteCheckConfiguration(ctx context.Context, project string, configurationId int) error {
+	tflog.Info(ctx, fmt.Sprintf("Deleting check configuration %d in project %s", configurationId, project))
+	err := s.client.DeleteCheckConfiguration(ctx, pipelineschecks.DeleteCheckConfigurationArgs{
+		Project: &project,
+		Id:      &configurationId,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete check configuration %d in azure devops: %w", configurationId, err)
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtracking"
+)
+
+func NewClassificationService(client *workitemtracking.ClientImpl) *ClassificationService {
+	return &ClassificationService{client: client}
+}
+
+// ClassificationService manages the area and iteration paths of a project.
+type ClassificationService struct {
+	client *workitemtracking.ClientImpl
+}
+
+// SplitClassificationPath splits a path like `Project\Team\Sub` into its
+// node names, both backslashes and slashes are accepted as separators.
+func SplitClassificationPath(path string) []string {
+	path = strings.Trim(strings.ReplaceAll(path, "/", "\\"), "\\")
+	if path == "" {
+		return []string{}
+	}
+	return strings.Split(path, "\\")
+}
+
+// GetClassificationNodeIds resolves a path like `Project\Team\Sub`, whose
+// first segment is the root node of the project, to the identifiers of the
+// nodes from the root node down to the node of the path.
+func (s *ClassificationService) GetClassificationNodeIds(ctx context.Context, project string, structureGroup workitemtracking.TreeStructureGroup, path string) ([]string, error) {
+	names := SplitClassificationPath(path)
+	if len(names) == 0 {
+		return nil, fmt.Errorf("the %s path is empty", structureGroup)
+	}
+
+	depth := len(names) - 1
+	node, err := s.client.GetClassificationNode(ctx, workitemtracking.GetClassificationNodeArgs{
+		Project:        &project,
+		StructureGroup: &structureGroup,
+		Depth:          &depth,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the %s of project %s from azure devops: %w", structureGroup, project, err)
+	}
+	if node.Name == nil || !strings.EqualFold(*node.Name, names[0]) {
+		return nil, fmt.Errorf("the %s path %s does not start with the root node of project %s: %w", structureGroup, path, project, ErrNotFound)
+	}
+
+	ids := []string{node.Identifier.String()}
+	for _, name := range names[1:] {
+		var child *workitemtracking.WorkItemClassificationNode
+		if node.Children != nil {
+			for i := range *node.Children {
+				if (*node.Children)[i].Name != nil && strings.EqualFold(*(*node.Children)[i].Name, name) {
+					child = &(*node.Children)[i]
+					break
+				}
+			}
+		}
+		if child == nil {
+			return nil, fmt.Errorf("the %s path %s does not exist in project %s: %w", structureGroup, path, project, ErrNotFound)
+		}
+		node = child
+		ids = append(ids, node.Identifier.String())
+	}
+
+	return ids, nil
+}
+
+// ClassificationNodePath returns the path of a node like `Project\Team\Sub`.
+// The api returns paths like `\Project\Area\Team\Sub` that include the name
+// of the structure group.
+func ClassificationNodePath(node *workitemtracking.WorkItemClassificationNode) string {
+	if node.Path == nil {
+		return ""
+	}
+	names := SplitClassificationPath(*node.Path)
+	if len(names) < 2 {
+		return strings.Join(names, "\\")
+	}
+	return strings.Join(append(names[:1:1], names[2:]...), "\\")
+}
+
+// relativeClassificationPath converts a path like `Project\Team\Sub` to the
+// path relative to the root node the api expects, nil for the root node.
+func relativeClassificationPath(path string) *string {
+	names := SplitClassificationPath(path)
+	if len(names) < 2 {
+		return nil
+	}
+	relativePath := strings.Join(names[1:], "/")
+	return &relativePath
+}
+
+// GetClassificationNode returns the node of a path like `Project\Team\Sub`,
+// or the root node when the path is empty, with its children down to depth.
+func (s *ClassificationService) GetClassificationNode(ctx context.Context, project string, structureGroup workitemtracking.TreeStructureGroup, path string, depth int) (*workitemtracking.WorkItemClassificationNode, error) {
+	var response, error = s.client.GetClassificationNode(ctx, workitemtracking.GetClassificationNodeArgs{
+		Project:        &project,
+		StructureGroup: &structureGroup,
+		Path:           relativeClassificationPath(path),
+		Depth:          &depth,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to read the %s path %s of project %s from azure devops: %w", structureGroup, path, project, error)
+		return &workitemtracking.WorkItemClassificationNode{}, error
+	}
+
+	return response, nil
+}
+
+// GetClassificationNodeById returns the node with the given integer ID, or nil
+// when it no longer exists.
+func (s *ClassificationService) GetClassificationNodeById(ctx context.Context, project string, id int) (*workitemtracking.WorkItemClassificationNode, error) {
+	errorPolicy := workitemtracking.ClassificationNodesErrorPolicyValues.Omit
+	var response, error = s.client.GetClassificationNodes(ctx, workitemtracking.GetClassificationNodesArgs{
+		Project:     &project,
+		Ids:         &[]int{id},
+		ErrorPolicy: &errorPolicy,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to read classification node %d of project %s from azure devops: %w", id, project, error)
+		return nil, error
+	}
+
+	for i := range *response {
+		if (*response)[i].Id != nil && *(*response)[i].Id == id {
+			return &(*response)[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateClassificationNode creates a node below the node of parentPath.
+func (s *ClassificationService) CreateClassificationNode(ctx context.Context, project string, structureGroup workitemtracking.TreeStructureGroup, parentPath string, node *workitemtracking.WorkItemClassificationNode) (*workitemtracking.WorkItemClassificationNode, error) {
+	tflog.Info(ctx, fmt.Sprintf("Creating %s path %s below %s in project %s", structureGroup, *node.Name, parentPath, project))
+	var response, error = s.client.CreateOrUpdateClassificationNode(ctx, workitemtracking.CreateOrUpdateClassificationNodeArgs{
+		Project:        &project,
+		StructureGroup: &structureGroup,
+		Path:           relativeClassificationPath(parentPath),
+		PostedNode:     node,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to create %s path %s in azure devops: %w", structureGroup, *node.Name, error)
+		return &workitemtracking.WorkItemClassificationNode{}, error
+	}
+
+	return response, nil
+}
+
+// MoveClassificationNode moves the node with the given integer ID below the node of parentPath.
+func (s *ClassificationService) MoveClassificationNode(ctx context.Context, project string, structureGroup workitemtracking.TreeStructureGroup, parentPath string, id int) error {
+	tflog.Info(ctx, fmt.Sprintf("Moving %s node %d below %s in project %s", structureGroup, id, parentPath, project))
+	_, err := s.client.CreateOrUpdateClassificationNode(ctx, workitemtracking.CreateOrUpdateClassificationNodeArgs{
+		Project:        &project,
+		StructureGroup: &structureGroup,
+		Path:           relativeClassificationPath(parentPath),
+		PostedNode:     &workitemtracking.WorkItemClassificationNode{Id: &id},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to move %s node %d below %s in azure devops: %w", structureGroup, id, parentPath, err)
+	}
+	return nil
+}
+
+// UpdateClassificationNode renames the node of path or updates its attributes.
+func (s *ClassificationService) UpdateClassificationNode(ctx context.Context, project string, structureGroup workitemtracking.TreeStructureGroup, path string, node *workitemtracking.WorkItemClassificationNode) (*workitemtracking.WorkItemClassificationNode, error) {
+	tflog.Info(ctx, fmt.Sprintf("Updating %s path %s in project %s", structureGroup, path, project))
+	var response, error = s.client.UpdateClassificationNode(ctx, workitemtracking.UpdateClassificationNodeArgs{
+		Project:        &project,
+		StructureGroup: &structureGroup,
+		Path:           relativeClassificationPath(path),
+		PostedNode:     node,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to update %s path %s in azure devops: %w", structureGroup, path, error)
+		return &workitemtracking.WorkItemClassificationNode{}, error
+	}
+
+	return response, nil
+}
+
+// DeleteClassificationNode deletes the node of path, its work items are moved
+// to the node with the integer ID reclassifyId.
+func (s *ClassificationService) DeleteClassificationNode(ctx context.Context, project string, structureGroup workitemtracking.TreeStructureGroup, path string, reclassifyId int) error {
+	tflog.Info(ctx, fmt.Sprintf("Deleting %s path %s in project %s", structureGroup, path, project))
+	err := s.client.DeleteClassificationNode(ctx, workitemtracking.DeleteClassificationNodeArgs{
+		Project:        &project,
+		StructureGroup: &structureGroup,
+		Path:           relativeClassificationPath(path),
+		ReclassifyId:   &reclassifyId,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete %s path %s from azure devops: %w", structureGroup, path, err)
+	}
+	return nil
+}
+
+// classificationTreeDepth is the depth of the nodes read at once, deeper nodes
+// are read by subtree.
+const classificationTreeDepth = 10
+
+// GetClassificationTree returns the root node of the area or iteration paths
+// of a project with all its descendants.
+func (s *ClassificationService) GetClassificationTree(ctx context.Context, project string, structureGroup workitemtracking.TreeStructureGroup) (*workitemtracking.WorkItemClassificationNode, error) {
+	root, err := s.GetClassificationNode(ctx, project, structureGroup, "", classificationTreeDepth)
+	if err != nil {
+		return root, err
+	}
+	err = s.expandClassificationNode(ctx, project, structureGroup, root, 0)
+	return root, err
+}
+
+// expandClassificationNode reads the children of the nodes below the depth
+// of a single read.
+func (s *ClassificationService) expandClassificationNode(ctx context.Context, project string, structureGroup workitemtracking.TreeStructureGroup, node *workitemtracking.WorkItemClassificationNode, depth int) error {
+	if depth == classificationTreeDepth && node.HasChildren != nil && *node.HasChildren && node.Children == nil {
+		subtree, err := s.GetClassificationNode(ctx, project, structureGroup, ClassificationNodePath(node), classificationTreeDepth)
+		if err != nil {
+			return err
+		}
+		node.Children = subtree.Children
+		depth = 0
+	}
+	if node.Children == nil {
+		return nil
+	}
+	for i := range *node.Children {
+		err := s.expandClassificationNode(ctx, project, structureGroup, &(*node.Children)[i], depth+1)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClassificationNodeDate returns the date attribute of an iteration node, for
+// example startDate, formatted as YYYY-MM-DD, or an empty string when not set.
+func ClassificationNodeDate(node *workitemtracking.WorkItemClassificationNode, name string) string {
+	if node.Attributes == nil {
+		return ""
+	}
+	date, ok := (*node.Attributes)[name].(string)
+	if !ok || len(date) < len("2006-01-02") {
+		return ""
+	}
+	return date[:len("2006-01-02")]
+}
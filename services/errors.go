@@ -0,0 +1,30 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+)
+
+// ErrNotFound is wrapped by the errors of lookups by name or path that find
+// no object, so IsNotFound reports them like missing objects of the api.
+var ErrNotFound = errors.New("not found")
+
+// IsNotFound reports whether err is an Azure DevOps API error with status code 404
+// or wraps ErrNotFound.
+func IsNotFound(err error) bool {
+	if errors.Is(err, ErrNotFound) {
+		return true
+	}
+	// The SDK returns wrapped errors both by value and by pointer.
+	var wrappedError azuredevops.WrappedError
+	if errors.As(err, &wrappedError) {
+		return wrappedError.StatusCode != nil && *wrappedError.StatusCode == http.StatusNotFound
+	}
+	var wrappedErrorPtr *azuredevops.WrappedError
+	if errors.As(err, &wrappedErrorPtr) {
+		return wrappedErrorPtr.StatusCode != nil && *wrappedErrorPtr.StatusCode == http.StatusNotFound
+	}
+	return false
+}
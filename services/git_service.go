@@ -0,0 +1,233 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+)
+
+// The disabled flag of the repositories is not part of the go api models, the
+// repositories are read and disabled through the generic client instead.
+var repositoriesLocationId = uuid.MustParse("225f7195-f9c7-4d14-ab28-a83f7ff77e1f")
+
+const repositoriesDisabledApiVersion = "6.0"
+
+// GitRepository is a repository including whether it is disabled.
+type GitRepository struct {
+	git.GitRepository
+	IsDisabled *bool `json:"isDisabled,omitempty"`
+}
+
+func NewGitService(client *git.ClientImpl) *GitService {
+	return &GitService{client: client}
+}
+
+type GitService struct {
+	client *git.ClientImpl
+}
+
+// GetRepository returns a repository of a project given its ID or name.
+func (s *GitService) GetRepository(ctx context.Context, project string, repository string) (*GitRepository, error) {
+	routeValues := map[string]string{
+		"project":      project,
+		"repositoryId": repository,
+	}
+	response, err := s.client.Client.Send(ctx, http.MethodGet, repositoriesLocationId, repositoriesDisabledApiVersion, routeValues, nil, nil, "", "application/json", nil)
+	if err != nil {
+		return &GitRepository{}, fmt.Errorf("failed to read repository %s from azure devops: %w", repository, err)
+	}
+
+	var gitRepository GitRepository
+	err = s.client.Client.UnmarshalBody(response, &gitRepository)
+	if err != nil {
+		return &GitRepository{}, fmt.Errorf("failed to read repository %s from azure devops: %w", repository, err)
+	}
+
+	return &gitRepository, nil
+}
+
+// CreateRepository creates a repository, or a fork when parentRepositoryId is set.
+func (s *GitService) CreateRepository(ctx context.Context, projectId string, name string, parentRepositoryId string) (*git.GitRepository, error) {
+	tflog.Info(ctx, fmt.Sprintf("Creating repository %s in project %s", name, projectId))
+	projectUuid, err := uuid.Parse(projectId)
+	if err != nil {
+		return &git.GitRepository{}, fmt.Errorf("invalid project ID %s: %w", projectId, err)
+	}
+	options := git.GitRepositoryCreateOptions{
+		Name:    &name,
+		Project: &core.TeamProjectReference{Id: &projectUuid},
+	}
+
+	if parentRepositoryId != "" {
+		// The parent repository may live in another project of the collection.
+		parent, err := s.client.GetRepository(ctx, git.GetRepositoryArgs{RepositoryId: &parentRepositoryId})
+		if err != nil {
+			return &git.GitRepository{}, fmt.Errorf("failed to read parent repository %s from azure devops: %w", parentRepositoryId, err)
+		}
+		options.ParentRepository = &git.GitRepositoryRef{
+			Id:      parent.Id,
+			Project: parent.Project,
+		}
+	}
+
+	var response, error = s.client.CreateRepository(ctx, git.CreateRepositoryArgs{
+		Project:               &projectId,
+		GitRepositoryToCreate: &options,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to create repository %s in azure devops: %w", name, error)
+		return &git.GitRepository{}, error
+	}
+
+	return response, nil
+}
+
+// UpdateRepository renames a repository or changes its default branch.
+func (s *GitService) UpdateRepository(ctx context.Context, projectId string, repositoryId uuid.UUID, update *git.GitRepository) error {
+	tflog.Info(ctx, fmt.Sprintf("Updating repository %s in project %s", repositoryId, projectId))
+	_, err := s.client.UpdateRepository(ctx, git.UpdateRepositoryArgs{
+		Project:           &projectId,
+		RepositoryId:      &repositoryId,
+		NewRepositoryInfo: update,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update repository %s in azure devops: %w", repositoryId, err)
+	}
+	return nil
+}
+
+func (s *GitService) SetRepositoryDisabled(ctx context.Context, projectId string, repositoryId uuid.UUID, disabled bool) error {
+	tflog.Info(ctx, fmt.Sprintf("Setting disabled of repository %s to %t", repositoryId, disabled))
+	body, err := json.Marshal(map[string]bool{"isDisabled": disabled})
+	if err != nil {
+		return err
+	}
+
+	routeValues := map[string]string{
+		"project":      projectId,
+		"repositoryId": repositoryId.String(),
+	}
+	_, err = s.client.Client.Send(ctx, http.MethodPatch, repositoriesLocationId, repositoriesDisabledApiVersion, routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return fmt.Errorf("failed to update repository %s in azure devops: %w", repositoryId, err)
+	}
+	return nil
+}
+
+func (s *GitService) DeleteRepository(ctx context.Context, projectId string, repositoryId uuid.UUID) error {
+	tflog.Info(ctx, fmt.Sprintf("Deleting repository %s in project %s", repositoryId, projectId))
+	err := s.client.DeleteRepository(ctx, git.DeleteRepositoryArgs{
+		Project:      &projectId,
+		RepositoryId: &repositoryId,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete repository %s in azure devops: %w", repositoryId, err)
+	}
+	return nil
+}
+
+// InitializeRepository pushes a first commit with an empty README.md to the branch.
+func (s *GitService) InitializeRepository(ctx context.Context, projectId string, repositoryId uuid.UUID, branchName string) error {
+	tflog.Info(ctx, fmt.Sprintf("Initializing branch %s of repository %s", branchName, repositoryId))
+	repository := repositoryId.String()
+	// A push from the empty object id creates the branch.
+	oldObjectId := "0000000000000000000000000000000000000000"
+	comment := "Initial commit."
+	path := "/README.md"
+	content := ""
+	changeType := git.VersionControlChangeTypeValues.Add
+	contentType := git.ItemContentTypeValues.RawText
+
+	_, err := s.client.CreatePush(ctx, git.CreatePushArgs{
+		Project:      &projectId,
+		RepositoryId: &repository,
+		Push: &git.GitPush{
+			RefUpdates: &[]git.GitRefUpdate{{
+				Name:        &branchName,
+				OldObjectId: &oldObjectId,
+			}},
+			Commits: &[]git.GitCommitRef{{
+				Comment: &comment,
+				Changes: &[]interface{}{
+					git.Change{
+						ChangeType: &changeType,
+						Item:       git.GitItem{Path: &path},
+						NewContent: &git.ItemContent{
+							Content:     &content,
+							ContentType: &contentType,
+						},
+					},
+				},
+			}},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to initialize repository %s in azure devops: %w", repositoryId, err)
+	}
+	return nil
+}
+
+// ImportRepository imports the content of a git repository at sourceUrl and
+// waits until the import completes.
+func (s *GitService) ImportRepository(ctx context.Context, projectId string, repositoryId uuid.UUID, sourceUrl string) error {
+	tflog.Info(ctx, fmt.Sprintf("Importing %s into repository %s", sourceUrl, repositoryId))
+	repository := repositoryId.String()
+	importRequest, err := s.client.CreateImportRequest(ctx, git.CreateImportRequestArgs{
+		Project:      &projectId,
+		RepositoryId: &repository,
+		ImportRequest: &git.GitImportRequest{
+			Parameters: &git.GitImportRequestParameters{
+				GitSource: &git.GitImportGitSource{Url: &sourceUrl},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to import %s into repository %s: %w", sourceUrl, repositoryId, err)
+	}
+
+	deadline := time.Now().Add(operationTimeout)
+	for {
+		status := git.GitAsyncOperationStatusValues.Queued
+		if importRequest.Status != nil {
+			status = *importRequest.Status
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Import of %s into repository %s is %s", sourceUrl, repositoryId, status))
+
+		switch status {
+		case git.GitAsyncOperationStatusValues.Completed:
+			return nil
+		case git.GitAsyncOperationStatusValues.Failed, git.GitAsyncOperationStatusValues.Abandoned:
+			message := ""
+			if importRequest.DetailedStatus != nil && importRequest.DetailedStatus.ErrorMessage != nil {
+				message = *importRequest.DetailedStatus.ErrorMessage
+			}
+			return fmt.Errorf("import of %s into repository %s %s: %s", sourceUrl, repositoryId, status, message)
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("import of %s into repository %s did not complete within %s", sourceUrl, repositoryId, operationTimeout)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(operationPollInterval):
+		}
+
+		importRequest, err = s.client.GetImportRequest(ctx, git.GetImportRequestArgs{
+			Project:         &projectId,
+			RepositoryId:    &repository,
+			ImportRequestId: importRequest.ImportRequestId,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to read import of repository %s: %w", repositoryId, err)
+		}
+	}
+}
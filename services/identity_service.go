@@ -6,6 +6,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
 )
@@ -38,6 +39,46 @@ func (s *IdentityService) GetIdentityByName(ctx context.Context, name string) (*
 	return &foundmember, nil
 }
 
+// GetIdentityByExactName searches identities by name and returns the single
+// one whose display name, account name or mail equals the name, compared
+// case-insensitively. Unlike GetIdentityByName it fails when the name is
+// ambiguous rather than returning the first hit of the search.
+func (s *IdentityService) GetIdentityByExactName(ctx context.Context, name string) (*identity.Identity, error) {
+	searchFilter := "General"
+	tflog.Info(ctx, fmt.Sprintf("Searching for member: %s", name))
+	var response, error = s.client.ReadIdentities(ctx, identity.ReadIdentitiesArgs{FilterValue: &name, SearchFilter: &searchFilter})
+	if error != nil {
+		error = fmt.Errorf("failed to read identities from azure devops: %w", error)
+		return &identity.Identity{}, error
+	}
+
+	var matches []identity.Identity
+	for _, foundmember := range *response {
+		if foundmember.Id == nil {
+			continue
+		}
+		candidates := []string{IdentityDisplayName(&foundmember), IdentityProperty(&foundmember, "Account"), IdentityProperty(&foundmember, "Mail")}
+		if foundmember.ProviderDisplayName != nil {
+			candidates = append(candidates, *foundmember.ProviderDisplayName)
+		}
+		for _, candidate := range candidates {
+			if candidate != "" && strings.EqualFold(candidate, name) {
+				matches = append(matches, foundmember)
+				break
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return &identity.Identity{}, fmt.Errorf("failed to find identity %s in azure devops: %w", name, ErrNotFound)
+	case 1:
+		return &matches[0], nil
+	default:
+		return &identity.Identity{}, fmt.Errorf("identity name %s is ambiguous, it matches %d identities in azure devops, use the ID or descriptor of the identity instead", name, len(matches))
+	}
+}
+
 func (s *IdentityService) GetIdentitiesByDescriptor(ctx context.Context, descriptor *string) (*[]identity.Identity, error) {
 	var foundmembers []identity.Identity
 	tflog.Info(ctx, fmt.Sprintf("Searching for descriptor: %s", *descriptor))
@@ -57,6 +98,91 @@ func (s *IdentityService) GetIdentitiesByDescriptor(ctx context.Context, descrip
 	return &foundmembers, nil
 }
 
+func (s *IdentityService) GetIdentityBySubjectDescriptor(ctx context.Context, subjectDescriptor string) (*identity.Identity, error) {
+	tflog.Info(ctx, fmt.Sprintf("Searching for subject descriptor: %s", subjectDescriptor))
+	var response, error = s.client.ReadIdentities(ctx, identity.ReadIdentitiesArgs{SubjectDescriptors: &subjectDescriptor})
+	if error != nil {
+		error = fmt.Errorf("failed to read identities from azure devops: %w", error)
+		return &identity.Identity{}, error
+	}
+
+	// Unknown descriptors are returned as null entries instead of an empty list.
+	for _, foundmember := range *response {
+		if foundmember.Id != nil {
+			return &foundmember, nil
+		}
+	}
+
+	return &identity.Identity{}, fmt.Errorf("failed to find identity with subject descriptor %s in azure devops: %w", subjectDescriptor, ErrNotFound)
+}
+
+func (s *IdentityService) GetIdentityById(ctx context.Context, id string) (*identity.Identity, error) {
+	tflog.Info(ctx, fmt.Sprintf("Searching for identity id: %s", id))
+	var response, error = s.client.ReadIdentity(ctx, identity.ReadIdentityArgs{IdentityId: &id})
+	if error != nil {
+		error = fmt.Errorf("failed to read identity %s from azure devops: %w", id, error)
+		return &identity.Identity{}, error
+	}
+	// Unknown or deleted identities are returned without an ID instead of a 404.
+	if response == nil || response.Id == nil {
+		return &identity.Identity{}, fmt.Errorf("failed to find identity %s in azure devops: %w", id, ErrNotFound)
+	}
+
+	return response, nil
+}
+
+// ResolveIdentityId returns the ID of an identity given either its ID, its
+// descriptor, its subject descriptor or its name, for example
+// [Project]\Contributors, user@example.com or a display name.
+func (s *IdentityService) ResolveIdentityId(ctx context.Context, value string) (string, error) {
+	if id, err := uuid.Parse(value); err == nil {
+		return id.String(), nil
+	}
+
+	// Identity descriptors contain the identity type, for example Microsoft.TeamFoundation.Identity;S-1-9-...
+	if strings.Contains(value, ";") {
+		identities, err := s.GetIdentitiesByDescriptor(ctx, &value)
+		if err != nil {
+			return "", err
+		}
+		for _, foundIdentity := range *identities {
+			if foundIdentity.Id != nil {
+				return foundIdentity.Id.String(), nil
+			}
+		}
+		return "", fmt.Errorf("failed to find identity with descriptor %s in azure devops", value)
+	}
+
+	// Subject descriptors never contain a backslash or an at sign, group and user names do.
+	if strings.ContainsAny(value, "\\@") {
+		foundIdentity, err := s.GetIdentityByExactName(ctx, value)
+		if err != nil {
+			return "", err
+		}
+		return foundIdentity.Id.String(), nil
+	}
+
+	foundIdentity, err := s.GetIdentityBySubjectDescriptor(ctx, value)
+	if IsNotFound(err) {
+		// Display names like "Build Service (DefaultCollection)" have neither.
+		foundIdentity, err = s.GetIdentityByExactName(ctx, value)
+	}
+	if err != nil {
+		return "", err
+	}
+	return foundIdentity.Id.String(), nil
+}
+
+// ResolveIdentity returns an identity given either its ID, its descriptor, its
+// subject descriptor or its name, see ResolveIdentityId.
+func (s *IdentityService) ResolveIdentity(ctx context.Context, value string) (*identity.Identity, error) {
+	id, err := s.ResolveIdentityId(ctx, value)
+	if err != nil {
+		return &identity.Identity{}, err
+	}
+	return s.GetIdentityById(ctx, id)
+}
+
 func (s *IdentityService) GetGroup(ctx context.Context, name string) (*identity.Identity, error) {
 	recurse := true
 	var response, error = s.client.ListGroups(ctx, identity.ListGroupsArgs{Recurse: &recurse})
@@ -113,6 +239,177 @@ func (s *IdentityService) GetGroupMembers(ctx context.Context, name string) (*[]
 	return &validMembers, nil
 }
 
+// GetMembers returns the direct members of a group or team identity.
+func (s *IdentityService) GetMembers(ctx context.Context, container *identity.Identity) (*[]identity.Identity, error) {
+	containerId := container.Id.String()
+	var response, error = s.client.ReadMembers(ctx, identity.ReadMembersArgs{ContainerId: &containerId})
+	if error != nil {
+		error = fmt.Errorf("failed to get members of %s from azure devops: %w", IdentityDisplayName(container), error)
+		return &[]identity.Identity{}, error
+	}
+	if len(*response) == 0 {
+		return &[]identity.Identity{}, nil
+	}
+
+	memberDescriptorsCombined := strings.Join(*response, ",")
+	members, err := s.GetIdentitiesByDescriptor(ctx, &memberDescriptorsCombined)
+	if err != nil {
+		return &[]identity.Identity{}, err
+	}
+	var validMembers []identity.Identity
+	for _, member := range *members {
+		if member.Id != nil {
+			validMembers = append(validMembers, member)
+		}
+	}
+
+	return &validMembers, nil
+}
+
+// GroupMember is an effective member of a group together with the chain of
+// group display names through which the membership was inherited, starting
+// with the queried group.
+type GroupMember struct {
+	Identity identity.Identity
+	Path     []string
+}
+
+func (s *IdentityService) GetExpandedGroupMembers(ctx context.Context, name string) (*[]GroupMember, error) {
+	var foundGroup, err = s.GetGroup(ctx, name)
+	if err != nil {
+		return &[]GroupMember{}, err
+	}
+
+	// The expanded membership query returns every transitive member in a
+	// single call, so all identities can be resolved up front and the walker
+	// below only has to read the direct members of each nested group.
+	var foundGroupId = foundGroup.Id.String()
+	expanded := identity.QueryMembershipValues.Expanded
+	var response, error = s.client.ReadMembers(ctx, identity.ReadMembersArgs{ContainerId: &foundGroupId, QueryMembership: &expanded})
+	if error != nil {
+		error = fmt.Errorf("failed to get expanded members of group %s from azure devops: %w", name, error)
+		return &[]GroupMember{}, error
+	}
+	if len(*response) == 0 {
+		return &[]GroupMember{}, nil
+	}
+
+	memberDescriptorsCombined := strings.Join(*response, ",")
+	members, err := s.GetIdentitiesByDescriptor(ctx, &memberDescriptorsCombined)
+	if err != nil {
+		return &[]GroupMember{}, err
+	}
+	identitiesByDescriptor := make(map[string]identity.Identity, len(*members))
+	for _, member := range *members {
+		if member.Descriptor != nil {
+			identitiesByDescriptor[*member.Descriptor] = member
+		}
+	}
+
+	type pendingGroup struct {
+		group identity.Identity
+		path  []string
+	}
+
+	// Walk the group tree breadth first so every user is reported with the
+	// shortest path through which it is inherited. Groups are only expanded
+	// once, which also guards against membership cycles.
+	visited := map[string]bool{foundGroupId: true}
+	seenUsers := map[string]bool{}
+	queue := []pendingGroup{{group: *foundGroup, path: []string{*foundGroup.ProviderDisplayName}}}
+	var effectiveMembers []GroupMember
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		currentId := current.group.Id.String()
+		direct, error := s.client.ReadMembers(ctx, identity.ReadMembersArgs{ContainerId: &currentId})
+		if error != nil {
+			error = fmt.Errorf("failed to get members of group %s from azure devops: %w", IdentityDisplayName(&current.group), error)
+			return &[]GroupMember{}, error
+		}
+
+		for _, descriptor := range *direct {
+			member, ok := identitiesByDescriptor[descriptor]
+			if !ok || member.Id == nil {
+				tflog.Warn(ctx, fmt.Sprintf("Skipping unresolved member %s of group %s", descriptor, IdentityDisplayName(&current.group)))
+				continue
+			}
+			memberId := member.Id.String()
+
+			if member.IsContainer != nil && *member.IsContainer {
+				if visited[memberId] {
+					continue
+				}
+				visited[memberId] = true
+				groupName := memberId
+				if member.ProviderDisplayName != nil {
+					groupName = *member.ProviderDisplayName
+				}
+				queue = append(queue, pendingGroup{group: member, path: append(slices.Clone(current.path), groupName)})
+				continue
+			}
+
+			if seenUsers[memberId] {
+				continue
+			}
+			seenUsers[memberId] = true
+			effectiveMembers = append(effectiveMembers, GroupMember{Identity: member, Path: current.path})
+		}
+	}
+
+	slices.SortFunc(effectiveMembers, func(i, j GroupMember) int {
+		return strings.Compare(IdentityDisplayName(&i.Identity), IdentityDisplayName(&j.Identity))
+	})
+
+	return &effectiveMembers, nil
+}
+
+func (s *IdentityService) GetGroupMembershipsOf(ctx context.Context, member *identity.Identity, queryMembership identity.QueryMembership) (*[]identity.Identity, error) {
+	memberId := member.Id.String()
+	var response, error = s.client.ReadMembersOf(ctx, identity.ReadMembersOfArgs{MemberId: &memberId, QueryMembership: &queryMembership})
+	if error != nil {
+		error = fmt.Errorf("failed to get memberships of %s from azure devops: %w", IdentityDisplayName(member), error)
+		return &[]identity.Identity{}, error
+	}
+	if len(*response) == 0 {
+		return &[]identity.Identity{}, nil
+	}
+
+	groupDescriptorsCombined := strings.Join(*response, ",")
+	groups, err := s.GetIdentitiesByDescriptor(ctx, &groupDescriptorsCombined)
+	if err != nil {
+		return &[]identity.Identity{}, err
+	}
+
+	var validGroups []identity.Identity
+	for _, group := range *groups {
+		// Skip the member itself should the server include it in the response.
+		if group.Id != nil && *group.Id != *member.Id {
+			validGroups = append(validGroups, group)
+		}
+	}
+
+	slices.SortFunc(validGroups, func(i, j identity.Identity) int {
+		return strings.Compare(IdentityDisplayName(&i), IdentityDisplayName(&j))
+	})
+
+	return &validGroups, nil
+}
+
+// IdentityDisplayName returns the custom display name of an identity when it
+// is set and falls back to the display name of the identity provider.
+func IdentityDisplayName(member *identity.Identity) string {
+	if member.CustomDisplayName != nil {
+		return *member.CustomDisplayName
+	}
+	if member.ProviderDisplayName != nil {
+		return *member.ProviderDisplayName
+	}
+	return ""
+}
+
 func (s *IdentityService) AddMemberToGroup(ctx context.Context, group *identity.Identity, member *identity.Identity) error {
 	containerId := group.Id.String()
 	memberId := member.Id.String()
@@ -136,7 +433,146 @@ func (s *IdentityService) RemoveMemberFromGroup(ctx context.Context, group *iden
 	}
 	_, err := s.client.RemoveMember(ctx, memberArgs)
 	if err != nil {
-		return fmt.Errorf("failed to remove member %s from group: %s: %w", *member.CustomDisplayName, *group.CustomDisplayName, err)
+		return fmt.Errorf("failed to remove member %s from group: %s: %w", IdentityDisplayName(member), IdentityDisplayName(group), err)
+	}
+	return nil
+}
+
+// IdentityProperty returns the string value of an identity property such as
+// ScopeName. Azure DevOps serializes properties as {"$type": ..., "$value": ...}.
+func IdentityProperty(member *identity.Identity, name string) string {
+	properties, ok := member.Properties.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	property, ok := properties[name].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	value, ok := property["$value"].(string)
+	if !ok {
+		return ""
+	}
+	return value
+}
+
+func (s *IdentityService) GetScopeByName(ctx context.Context, name string) (*identity.IdentityScope, error) {
+	tflog.Info(ctx, fmt.Sprintf("Searching for scope: %s", name))
+	var response, error = s.client.GetScopeByName(ctx, identity.GetScopeByNameArgs{ScopeName: &name})
+	if error != nil {
+		error = fmt.Errorf("failed to read scope %s from azure devops: %w", name, error)
+		return &identity.IdentityScope{}, error
+	}
+
+	return response, nil
+}
+
+func (s *IdentityService) GetScopeById(ctx context.Context, id uuid.UUID) (*identity.IdentityScope, error) {
+	tflog.Info(ctx, fmt.Sprintf("Searching for scope id: %s", id))
+	var response, error = s.client.GetScopeById(ctx, identity.GetScopeByIdArgs{ScopeId: &id})
+	if error != nil {
+		error = fmt.Errorf("failed to read scope %s from azure devops: %w", id, error)
+		return &identity.IdentityScope{}, error
+	}
+
+	return response, nil
+}
+
+// ListScopes returns every scope that contains at least one group. The
+// identity API has no endpoint to enumerate scopes, so they are collected
+// from the ScopeId property of all groups in the collection.
+func (s *IdentityService) ListScopes(ctx context.Context) (*[]identity.IdentityScope, error) {
+	recurse := true
+	var response, error = s.client.ListGroups(ctx, identity.ListGroupsArgs{Recurse: &recurse})
+	if error != nil {
+		error = fmt.Errorf("failed to list groups from azure devops: %w", error)
+		return &[]identity.IdentityScope{}, error
+	}
+
+	var scopes []identity.IdentityScope
+	seenScopes := map[uuid.UUID]bool{}
+	for _, group := range *response {
+		scopeId, err := uuid.Parse(IdentityProperty(&group, "ScopeId"))
+		if err != nil || seenScopes[scopeId] {
+			continue
+		}
+		seenScopes[scopeId] = true
+
+		scope, err := s.GetScopeById(ctx, scopeId)
+		if err != nil {
+			return &[]identity.IdentityScope{}, err
+		}
+		if scope.Name != nil {
+			scopes = append(scopes, *scope)
+		}
+	}
+
+	slices.SortFunc(scopes, func(i, j identity.IdentityScope) int {
+		return strings.Compare(*i.Name, *j.Name)
+	})
+
+	return &scopes, nil
+}
+
+func (s *IdentityService) GetSelf(ctx context.Context) (*identity.IdentitySelf, error) {
+	var response, error = s.client.GetSelf(ctx, identity.GetSelfArgs{})
+	if error != nil {
+		error = fmt.Errorf("failed to read the authenticated identity from azure devops: %w", error)
+		return &identity.IdentitySelf{}, error
+	}
+
+	return response, nil
+}
+
+type createGroupsContainer struct {
+	ScopeId string              `json:"scopeId"`
+	Groups  []identity.Identity `json:"groups"`
+}
+
+func (s *IdentityService) CreateGroup(ctx context.Context, scopeId uuid.UUID, name string, description string) (*identity.Identity, error) {
+	container := createGroupsContainer{
+		ScopeId: scopeId.String(),
+		Groups: []identity.Identity{{
+			ProviderDisplayName: &name,
+			Properties:          newDescriptionProperty(description),
+		}},
+	}
+	var response, error = s.client.CreateGroups(ctx, identity.CreateGroupsArgs{Container: container})
+	if error != nil {
+		error = fmt.Errorf("failed to create group %s in azure devops: %w", name, error)
+		return &identity.Identity{}, error
+	}
+	if len(*response) == 0 {
+		return &identity.Identity{}, fmt.Errorf("failed to create group %s in azure devops: no group returned", name)
+	}
+
+	return &(*response)[0], nil
+}
+
+func (s *IdentityService) UpdateGroup(ctx context.Context, group *identity.Identity, name string, description string) error {
+	group.ProviderDisplayName = &name
+	group.Properties = newDescriptionProperty(description)
+	error := s.client.UpdateIdentity(ctx, identity.UpdateIdentityArgs{Identity: group, IdentityId: group.Id})
+	if error != nil {
+		return fmt.Errorf("failed to update group %s in azure devops: %w", name, error)
 	}
 	return nil
 }
+
+func (s *IdentityService) DeleteGroup(ctx context.Context, group *identity.Identity) error {
+	groupId := group.Id.String()
+	error := s.client.DeleteGroup(ctx, identity.DeleteGroupArgs{GroupId: &groupId})
+	if error != nil {
+		return fmt.Errorf("failed to delete group %s from azure devops: %w", IdentityDisplayName(group), error)
+	}
+	return nil
+}
+
+func newDescriptionProperty(description string) map[string]interface{} {
+	return map[string]interface{}{
+		"Description": map[string]interface{}{
+			"$type":  "System.String",
+			"$value": description,
+		},
+	}
+}
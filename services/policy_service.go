@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+)
+
+// Well-known policy types of the Git branch policies.
+var (
+	MinimumReviewersPolicyTypeId  = uuid.MustParse("fa4e907d-c16b-4a4c-9dfa-4906e5d171dd")
+	BuildValidationPolicyTypeId   = uuid.MustParse("0609b952-1397-4640-95ec-e00a01b2c241")
+	RequiredReviewersPolicyTypeId = uuid.MustParse("fd2167ab-b0be-447a-8ec8-39368250530e")
+	CommentResolutionPolicyTypeId = uuid.MustParse("c6a1889d-b943-4856-b76f-9e46bb6b0df2")
+	WorkItemLinkingPolicyTypeId   = uuid.MustParse("40e92b44-2fe1-4dd6-b3d8-74a9c21d0c6e")
+	MergeTypesPolicyTypeId        = uuid.MustParse("fa4e907d-c16b-4a4c-9dfa-4916e5d171ab")
+)
+
+func NewPolicyService(client *policy.ClientImpl) *PolicyService {
+	return &PolicyService{client: client}
+}
+
+type PolicyService struct {
+	client *policy.ClientImpl
+}
+
+func (s *PolicyService) GetPolicyConfiguration(ctx context.Context, project string, configurationId int) (*policy.PolicyConfiguration, error) {
+	var response, error = s.client.GetPolicyConfiguration(ctx, policy.GetPolicyConfigurationArgs{
+		Project:         &project,
+		ConfigurationId: &configurationId,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to read policy configuration %d from azure devops: %w", configurationId, error)
+		return &policy.PolicyConfiguration{}, error
+	}
+
+	return response, nil
+}
+
+func (s *PolicyService) CreatePolicyConfiguration(ctx context.Context, project string, configuration *policy.PolicyConfiguration) (*policy.PolicyConfiguration, error) {
+	tflog.Info(ctx, fmt.Sprintf("Creating policy configuration of type %s in project %s", configuration.Type.Id, project))
+	var response, error = s.client.CreatePolicyConfiguration(ctx, policy.CreatePolicyConfigurationArgs{
+		Project:       &project,
+		Configuration: configuration,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to create policy configuration in azure devops: %w", error)
+		return &policy.PolicyConfiguration{}, error
+	}
+
+	return response, nil
+}
+
+func (s *PolicyService) UpdatePolicyConfiguration(ctx context.Context, project string, configurationId int, configuration *policy.PolicyConfiguration) (*policy.PolicyConfiguration, error) {
+	tflog.Info(ctx, fmt.Sprintf("Updating policy configuration %d in project %s", configurationId, project))
+	var response, error = s.client.UpdatePolicyConfiguration(ctx, policy.UpdatePolicyConfigurationArgs{
+		Project:         &project,
+		ConfigurationId: &configurationId,
+		Configuration:   configuration,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to update policy configuration %d in azure devops: %w", configurationId, error)
+		return &policy.PolicyConfiguration{}, error
+	}
+
+	return response, nil
+}
+
+func (s *PolicyService) DeletePolicyConfiguration(ctx context.Context, project string, configurationId int) error {
+	tflog.Info(ctx, fmt.Sprintf("Deleting policy configuration %d in project %s", configurationId, project))
+	err := s.client.DeletePolicyConfiguration(ctx, policy.DeletePolicyConfigurationArgs{
+		Project:         &project,
+		ConfigurationId: &configurationId,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete policy configuration %d in azure devops: %w", configurationId, err)
+	}
+	return nil
+}
+
+func (s *PolicyService) GetPolicyTypes(ctx context.Context, project string) (*[]policy.PolicyType, error) {
+	var response, error = s.client.GetPolicyTypes(ctx, policy.GetPolicyTypesArgs{Project: &project})
+	if error != nil {
+		error = fmt.Errorf("failed to list policy types of project %s from azure devops: %w", project, error)
+		return &[]policy.PolicyType{}, error
+	}
+
+	return response, nil
+}
@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/operations"
+)
+
+// Capabilities of a project holding its version control and process template.
+const (
+	VersionControlCapability  = "versioncontrol"
+	ProcessTemplateCapability = "processTemplate"
+)
+
+// Interval and maximum duration of the polling of the project operations.
+const (
+	operationPollInterval = 2 * time.Second
+	operationTimeout      = 10 * time.Minute
+)
+
+func NewProjectService(client *core.ClientImpl, operationsClient *operations.ClientImpl) *ProjectService {
+	return &ProjectService{client: client, operationsClient: operationsClient}
+}
+
+type ProjectService struct {
+	client           *core.ClientImpl
+	operationsClient *operations.ClientImpl
+}
+
+// GetProject returns a project given its ID or name, including its capabilities.
+func (s *ProjectService) GetProject(ctx context.Context, project string) (*core.TeamProject, error) {
+	includeCapabilities := true
+	var response, error = s.client.GetProject(ctx, core.GetProjectArgs{
+		ProjectId:           &project,
+		IncludeCapabilities: &includeCapabilities,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to read project %s from azure devops: %w", project, error)
+		return &core.TeamProject{}, error
+	}
+
+	return response, nil
+}
+
+// GetProjects returns all projects in the given state, following the continuation tokens.
+func (s *ProjectService) GetProjects(ctx context.Context, state core.ProjectState) ([]core.TeamProjectReference, error) {
+	projects := []core.TeamProjectReference{}
+	var continuationToken *string
+	for {
+		var response, error = s.client.GetProjects(ctx, core.GetProjectsArgs{
+			StateFilter:       &state,
+			ContinuationToken: continuationToken,
+		})
+		if error != nil {
+			error = fmt.Errorf("failed to list projects from azure devops: %w", error)
+			return projects, error
+		}
+
+		projects = append(projects, response.Value...)
+		if response.ContinuationToken == "" {
+			return projects, nil
+		}
+		continuationToken = &response.ContinuationToken
+	}
+}
+
+// GetProcess returns a process template given its name, or the default
+// process template of the collection when name is empty.
+func (s *ProjectService) GetProcess(ctx context.Context, name string) (*core.Process, error) {
+	var response, error = s.client.GetProcesses(ctx, core.GetProcessesArgs{})
+	if error != nil {
+		error = fmt.Errorf("failed to list processes from azure devops: %w", error)
+		return &core.Process{}, error
+	}
+
+	for _, process := range *response {
+		if name == "" && process.IsDefault != nil && *process.IsDefault {
+			return &process, nil
+		}
+		if name != "" && process.Name != nil && strings.EqualFold(*process.Name, name) {
+			return &process, nil
+		}
+	}
+
+	if name == "" {
+		return &core.Process{}, fmt.Errorf("the collection has no default process")
+	}
+	return &core.Process{}, fmt.Errorf("process %s not found", name)
+}
+
+// CreateProject queues the creation of a project and waits until it is created.
+// When the creation was queued but did not complete, the project is returned
+// along with the error if it exists, so that it can still be deleted.
+func (s *ProjectService) CreateProject(ctx context.Context, project *core.TeamProject) (*core.TeamProject, error) {
+	tflog.Info(ctx, fmt.Sprintf("Creating project %s", *project.Name))
+	operation, err := s.client.QueueCreateProject(ctx, core.QueueCreateProjectArgs{ProjectToCreate: project})
+	if err != nil {
+		return &core.TeamProject{}, fmt.Errorf("failed to create project %s in azure devops: %w", *project.Name, err)
+	}
+
+	err = s.waitForOperation(ctx, operation)
+	if err != nil {
+		err = fmt.Errorf("failed to create project %s in azure devops: %w", *project.Name, err)
+		if created, getErr := s.GetProject(ctx, *project.Name); getErr == nil {
+			return created, err
+		}
+		return &core.TeamProject{}, err
+	}
+
+	return s.GetProject(ctx, *project.Name)
+}
+
+// UpdateProject queues the update of a project and waits until it is updated.
+func (s *ProjectService) UpdateProject(ctx context.Context, projectId uuid.UUID, project *core.TeamProject) error {
+	tflog.Info(ctx, fmt.Sprintf("Updating project %s", projectId))
+	operation, err := s.client.UpdateProject(ctx, core.UpdateProjectArgs{
+		ProjectId:     &projectId,
+		ProjectUpdate: project,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update project %s in azure devops: %w", projectId, err)
+	}
+
+	err = s.waitForOperation(ctx, operation)
+	if err != nil {
+		return fmt.Errorf("failed to update project %s in azure devops: %w", projectId, err)
+	}
+	return nil
+}
+
+// DeleteProject queues the deletion of a project and waits until it is deleted.
+func (s *ProjectService) DeleteProject(ctx context.Context, projectId uuid.UUID) error {
+	tflog.Info(ctx, fmt.Sprintf("Deleting project %s", projectId))
+	operation, err := s.client.QueueDeleteProject(ctx, core.QueueDeleteProjectArgs{ProjectId: &projectId})
+	if err != nil {
+		return fmt.Errorf("failed to delete project %s in azure devops: %w", projectId, err)
+	}
+
+	err = s.waitForOperation(ctx, operation)
+	if err != nil {
+		return fmt.Errorf("failed to delete project %s in azure devops: %w", projectId, err)
+	}
+	return nil
+}
+
+// waitForOperation polls a long-running operation until it succeeds, fails or times out.
+func (s *ProjectService) waitForOperation(ctx context.Context, reference *operations.OperationReference) error {
+	if reference == nil || reference.Id == nil {
+		return fmt.Errorf("the operation was not queued")
+	}
+
+	deadline := time.Now().Add(operationTimeout)
+	for {
+		operation, err := s.operationsClient.GetOperation(ctx, operations.GetOperationArgs{
+			OperationId: reference.Id,
+			PluginId:    reference.PluginId,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to read operation %s: %w", reference.Id, err)
+		}
+
+		status := operations.OperationStatusValues.NotSet
+		if operation.Status != nil {
+			status = *operation.Status
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Operation %s is %s", reference.Id, status))
+
+		switch status {
+		case operations.OperationStatusValues.Succeeded:
+			return nil
+		case operations.OperationStatusValues.Failed, operations.OperationStatusValues.Cancelled:
+			message := ""
+			if operation.ResultMessage != nil {
+				message = *operation.ResultMessage
+			}
+			return fmt.Errorf("operation %s %s: %s", reference.Id, status, message)
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("operation %s did not complete within %s", reference.Id, operationTimeout)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(operationPollInterval):
+		}
+	}
+}
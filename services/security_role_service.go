@@ -0,0 +1,166 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+)
+
+// The go api has no client for the security roles area, the role assignments
+// are sent through the generic client of the collection.
+var roleAssignmentsLocationId = uuid.MustParse("9461c234-c84c-4ed2-b918-2f0f57e66ab5")
+
+const roleAssignmentsApiVersion = "5.0-preview.1"
+
+// Scopes of the role assignments of the distributed task resources.
+const (
+	ServiceEndpointRoleScope = "distributedtask.serviceendpointrole"
+	LibraryRoleScope         = "distributedtask.library"
+	VariableGroupRoleScope   = "distributedtask.variablegroup"
+	AgentPoolRoleScope       = "distributedtask.agentpoolrole"
+	AgentQueueRoleScope      = "distributedtask.agentqueuerole"
+	EnvironmentRoleScope     = "distributedtask.environmentreferencerole"
+)
+
+// RoleAssignment is the role of an identity on a resource.
+type RoleAssignment struct {
+	// Access is assigned for roles set on the resource and inherited for
+	// roles inherited from a parent resource.
+	Access   *string            `json:"access,omitempty"`
+	Identity *RoleIdentity      `json:"identity,omitempty"`
+	Role     *SecurityRoleModel `json:"role,omitempty"`
+}
+
+type RoleIdentity struct {
+	Id          *string `json:"id,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+}
+
+type SecurityRoleModel struct {
+	Name        *string `json:"name,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+	Scope       *string `json:"scope,omitempty"`
+}
+
+type setRoleAssignment struct {
+	RoleName string `json:"roleName"`
+	UserId   string `json:"userId"`
+}
+
+func NewSecurityRoleService(client *azuredevops.Client) *SecurityRoleService {
+	return &SecurityRoleService{client: client}
+}
+
+type SecurityRoleService struct {
+	client *azuredevops.Client
+}
+
+func (s *SecurityRoleService) GetRoleAssignments(ctx context.Context, scope string, resourceId string) (*[]RoleAssignment, error) {
+	response, err := s.client.Send(ctx, http.MethodGet, roleAssignmentsLocationId, roleAssignmentsApiVersion, roleAssignmentsRouteValues(scope, resourceId), nil, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read role assignments of %s %s from azure devops: %w", scope, resourceId, err)
+	}
+
+	var assignments []RoleAssignment
+	err = s.client.UnmarshalCollectionBody(response, &assignments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read role assignments of %s %s from azure devops: %w", scope, resourceId, err)
+	}
+
+	return &assignments, nil
+}
+
+// GetRoleAssignment returns the role assigned to an identity on the resource,
+// nil when no role is assigned on the resource itself.
+func (s *SecurityRoleService) GetRoleAssignment(ctx context.Context, scope string, resourceId string, identityId string) (*RoleAssignment, error) {
+	assignments, err := s.GetRoleAssignments(ctx, scope, resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, assignment := range *assignments {
+		if assignment.Identity == nil || assignment.Identity.Id == nil || !strings.EqualFold(*assignment.Identity.Id, identityId) {
+			continue
+		}
+		if assignment.Access != nil && !strings.EqualFold(*assignment.Access, "assigned") {
+			continue
+		}
+		return &assignment, nil
+	}
+
+	return nil, nil
+}
+
+func (s *SecurityRoleService) SetRoleAssignment(ctx context.Context, scope string, resourceId string, identityId string, roleName string) error {
+	tflog.Info(ctx, fmt.Sprintf("Assigning role %s on %s %s to %s", roleName, scope, resourceId, identityId))
+	body, err := json.Marshal([]setRoleAssignment{{RoleName: roleName, UserId: identityId}})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Send(ctx, http.MethodPut, roleAssignmentsLocationId, roleAssignmentsApiVersion, roleAssignmentsRouteValues(scope, resourceId), nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return fmt.Errorf("failed to assign role %s on %s %s in azure devops: %w", roleName, scope, resourceId, err)
+	}
+
+	return nil
+}
+
+func (s *SecurityRoleService) RemoveRoleAssignment(ctx context.Context, scope string, resourceId string, identityId string) error {
+	tflog.Info(ctx, fmt.Sprintf("Removing role assignment on %s %s of %s", scope, resourceId, identityId))
+	body, err := json.Marshal([]string{identityId})
+	if err != nil {
+		return err
+	}
+
+	// Role assignments are removed by patching the resource with the identities to remove.
+	_, err = s.client.Send(ctx, http.MethodPatch, roleAssignmentsLocationId, roleAssignmentsApiVersion, roleAssignmentsRouteValues(scope, resourceId), nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return fmt.Errorf("failed to remove role assignment on %s %s in azure devops: %w", scope, resourceId, err)
+	}
+
+	return nil
+}
+
+// ServiceEndpointRoleResourceId returns the resource of the roles of a
+// service endpoint, or of all service endpoints of the project when
+// endpointId is empty.
+func ServiceEndpointRoleResourceId(projectId string, endpointId string) string {
+	if endpointId == "" {
+		endpointId = "0"
+	}
+	return projectId + "_" + endpointId
+}
+
+// LibraryRoleResourceId returns the resource of the roles of a variable
+// group, or of the library of the project when variableGroupId is empty.
+func LibraryRoleResourceId(projectId string, variableGroupId string) string {
+	if variableGroupId == "" {
+		variableGroupId = "0"
+	}
+	return projectId + "$" + variableGroupId
+}
+
+// AgentQueueRoleResourceId returns the resource of the roles of an agent queue.
+func AgentQueueRoleResourceId(projectId string, queueId string) string {
+	return projectId + "_" + queueId
+}
+
+// EnvironmentRoleResourceId returns the resource of the roles of an environment.
+func EnvironmentRoleResourceId(projectId string, environmentId string) string {
+	return projectId + "_" + environmentId
+}
+
+func roleAssignmentsRouteValues(scope string, resourceId string) map[string]string {
+	return map[string]string{
+		"scopeId":    scope,
+		"resourceId": resourceId,
+	}
+}
@@ -0,0 +1,397 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+)
+
+// Permission values accepted by the permission resources.
+const (
+	PermissionAllow  = "Allow"
+	PermissionDeny   = "Deny"
+	PermissionNotSet = "NotSet"
+)
+
+var PermissionValues = []string{PermissionAllow, PermissionDeny, PermissionNotSet}
+
+func NewSecurityService(client *security.ClientImpl) *SecurityService {
+	return &SecurityService{client: client}
+}
+
+type SecurityService struct {
+	client *security.ClientImpl
+}
+
+type setAccessControlEntriesContainer struct {
+	Token                string                        `json:"token"`
+	Merge                bool                          `json:"merge"`
+	AccessControlEntries []security.AccessControlEntry `json:"accessControlEntries"`
+}
+
+func (s *SecurityService) GetNamespace(ctx context.Context, namespaceId uuid.UUID) (*security.SecurityNamespaceDescription, error) {
+	var response, error = s.client.QuerySecurityNamespaces(ctx, security.QuerySecurityNamespacesArgs{SecurityNamespaceId: &namespaceId})
+	if error != nil {
+		error = fmt.Errorf("failed to read security namespace %s from azure devops: %w", namespaceId, error)
+		return &security.SecurityNamespaceDescription{}, error
+	}
+
+	if len(*response) == 0 {
+		return &security.SecurityNamespaceDescription{}, fmt.Errorf("security namespace %s not found", namespaceId)
+	}
+
+	return &(*response)[0], nil
+}
+
+func (s *SecurityService) GetNamespaceByName(ctx context.Context, name string) (*security.SecurityNamespaceDescription, error) {
+	var response, error = s.client.QuerySecurityNamespaces(ctx, security.QuerySecurityNamespacesArgs{})
+	if error != nil {
+		error = fmt.Errorf("failed to list security namespaces from azure devops: %w", error)
+		return &security.SecurityNamespaceDescription{}, error
+	}
+
+	for _, namespace := range *response {
+		if namespace.Name != nil && strings.EqualFold(*namespace.Name, name) {
+			return &namespace, nil
+		}
+	}
+
+	return &security.SecurityNamespaceDescription{}, fmt.Errorf("security namespace %s not found", name)
+}
+
+func (s *SecurityService) GetAccessControlEntry(ctx context.Context, namespaceId uuid.UUID, token string, descriptor string) (*security.AccessControlEntry, error) {
+	tflog.Info(ctx, fmt.Sprintf("Reading access control entry of %s on token %s", descriptor, token))
+	var response, error = s.client.QueryAccessControlLists(ctx, security.QueryAccessControlListsArgs{
+		SecurityNamespaceId: &namespaceId,
+		Token:               &token,
+		Descriptors:         &descriptor,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to read access control list of token %s from azure devops: %w", token, error)
+		return &security.AccessControlEntry{}, error
+	}
+
+	// A missing entry is returned as an empty entry so callers can treat it as NotSet.
+	emptyAllow, emptyDeny := 0, 0
+	foundEntry := security.AccessControlEntry{Descriptor: &descriptor, Allow: &emptyAllow, Deny: &emptyDeny}
+	for _, acl := range *response {
+		if acl.Token == nil || !strings.EqualFold(*acl.Token, token) || acl.AcesDictionary == nil {
+			continue
+		}
+		for aceDescriptor, ace := range *acl.AcesDictionary {
+			if strings.EqualFold(aceDescriptor, descriptor) {
+				foundEntry.Allow = ace.Allow
+				foundEntry.Deny = ace.Deny
+			}
+		}
+	}
+	if foundEntry.Allow == nil {
+		foundEntry.Allow = &emptyAllow
+	}
+	if foundEntry.Deny == nil {
+		foundEntry.Deny = &emptyDeny
+	}
+
+	return &foundEntry, nil
+}
+
+// GetEffectiveAccessControlEntry returns the access control entry of a
+// principal on a token including its extended information, which holds the
+// inherited and effective permissions of the principal.
+func (s *SecurityService) GetEffectiveAccessControlEntry(ctx context.Context, namespaceId uuid.UUID, token string, descriptor string) (*security.AccessControlEntry, error) {
+	tflog.Info(ctx, fmt.Sprintf("Reading effective permissions of %s on token %s", descriptor, token))
+	includeExtendedInfo := true
+	var response, error = s.client.QueryAccessControlLists(ctx, security.QueryAccessControlListsArgs{
+		SecurityNamespaceId: &namespaceId,
+		Token:               &token,
+		Descriptors:         &descriptor,
+		IncludeExtendedInfo: &includeExtendedInfo,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to read access control list of token %s from azure devops: %w", token, error)
+		return &security.AccessControlEntry{}, error
+	}
+
+	emptyAllow, emptyDeny := 0, 0
+	foundEntry := security.AccessControlEntry{Descriptor: &descriptor, Allow: &emptyAllow, Deny: &emptyDeny, ExtendedInfo: &security.AceExtendedInformation{}}
+	for _, acl := range *response {
+		if acl.Token == nil || !strings.EqualFold(*acl.Token, token) || acl.AcesDictionary == nil {
+			continue
+		}
+		for aceDescriptor, ace := range *acl.AcesDictionary {
+			if !strings.EqualFold(aceDescriptor, descriptor) {
+				continue
+			}
+			if ace.Allow != nil {
+				foundEntry.Allow = ace.Allow
+			}
+			if ace.Deny != nil {
+				foundEntry.Deny = ace.Deny
+			}
+			if ace.ExtendedInfo != nil {
+				foundEntry.ExtendedInfo = ace.ExtendedInfo
+			}
+		}
+	}
+
+	return &foundEntry, nil
+}
+
+// HasPermission evaluates whether the authenticated identity has the given
+// permission bits on a token.
+func (s *SecurityService) HasPermission(ctx context.Context, namespaceId uuid.UUID, token string, bits int) (bool, error) {
+	var response, error = s.client.HasPermissions(ctx, security.HasPermissionsArgs{
+		SecurityNamespaceId: &namespaceId,
+		Permissions:         &bits,
+		Tokens:              &token,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to evaluate permissions on token %s in azure devops: %w", token, error)
+		return false, error
+	}
+
+	return len(*response) > 0 && (*response)[0], nil
+}
+
+func (s *SecurityService) SetAccessControlEntry(ctx context.Context, namespaceId uuid.UUID, token string, ace *security.AccessControlEntry) error {
+	tflog.Info(ctx, fmt.Sprintf("Setting access control entry of %s on token %s", *ace.Descriptor, token))
+	container := setAccessControlEntriesContainer{
+		Token:                token,
+		Merge:                false,
+		AccessControlEntries: []security.AccessControlEntry{*ace},
+	}
+	_, err := s.client.SetAccessControlEntries(ctx, security.SetAccessControlEntriesArgs{SecurityNamespaceId: &namespaceId, Container: container})
+	if err != nil {
+		return fmt.Errorf("failed to set access control entry of %s on token %s: %w", *ace.Descriptor, token, err)
+	}
+	return nil
+}
+
+func (s *SecurityService) RemoveAccessControlEntry(ctx context.Context, namespaceId uuid.UUID, token string, descriptor string) error {
+	tflog.Info(ctx, fmt.Sprintf("Removing access control entry of %s on token %s", descriptor, token))
+	_, err := s.client.RemoveAccessControlEntries(ctx, security.RemoveAccessControlEntriesArgs{
+		SecurityNamespaceId: &namespaceId,
+		Token:               &token,
+		Descriptors:         &descriptor,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove access control entry of %s on token %s: %w", descriptor, token, err)
+	}
+	return nil
+}
+
+// GetAccessControlList returns the access control list of a token. A token
+// without an access control list is returned as an empty list that inherits
+// permissions.
+func (s *SecurityService) GetAccessControlList(ctx context.Context, namespaceId uuid.UUID, token string) (*security.AccessControlList, error) {
+	tflog.Info(ctx, fmt.Sprintf("Reading access control list of token %s", token))
+	var response, error = s.client.QueryAccessControlLists(ctx, security.QueryAccessControlListsArgs{
+		SecurityNamespaceId: &namespaceId,
+		Token:               &token,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to read access control list of token %s from azure devops: %w", token, error)
+		return &security.AccessControlList{}, error
+	}
+
+	for _, acl := range *response {
+		if acl.Token != nil && strings.EqualFold(*acl.Token, token) {
+			if acl.AcesDictionary == nil {
+				acl.AcesDictionary = &map[string]security.AccessControlEntry{}
+			}
+			if acl.InheritPermissions == nil {
+				inheritPermissions := true
+				acl.InheritPermissions = &inheritPermissions
+			}
+			return &acl, nil
+		}
+	}
+
+	inheritPermissions := true
+	return &security.AccessControlList{
+		Token:              &token,
+		InheritPermissions: &inheritPermissions,
+		AcesDictionary:     &map[string]security.AccessControlEntry{},
+	}, nil
+}
+
+// SetAccessControlList replaces the access control list of a token, both its
+// entries and whether it inherits permissions.
+func (s *SecurityService) SetAccessControlList(ctx context.Context, namespaceId uuid.UUID, acl *security.AccessControlList) error {
+	tflog.Info(ctx, fmt.Sprintf("Setting access control list of token %s", *acl.Token))
+	count := 1
+	value := []interface{}{*acl}
+	err := s.client.SetAccessControlLists(ctx, security.SetAccessControlListsArgs{
+		SecurityNamespaceId: &namespaceId,
+		AccessControlLists:  &azuredevops.VssJsonCollectionWrapper{Count: &count, Value: &value},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set access control list of token %s: %w", *acl.Token, err)
+	}
+	return nil
+}
+
+// RemoveAccessControlList removes the access control list of a token, which
+// removes all its entries and restores the inheritance of permissions.
+func (s *SecurityService) RemoveAccessControlList(ctx context.Context, namespaceId uuid.UUID, token string) error {
+	tflog.Info(ctx, fmt.Sprintf("Removing access control list of token %s", token))
+	_, err := s.client.RemoveAccessControlLists(ctx, security.RemoveAccessControlListsArgs{
+		SecurityNamespaceId: &namespaceId,
+		Tokens:              &token,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove access control list of token %s: %w", token, err)
+	}
+	return nil
+}
+
+// GetActionBit returns the permission bit of the action with the given name
+// or display name, compared case-insensitively.
+func GetActionBit(namespace *security.SecurityNamespaceDescription, name string) (int, error) {
+	if namespace.Actions != nil {
+		for _, action := range *namespace.Actions {
+			if action.Name != nil && action.Bit != nil && strings.EqualFold(*action.Name, name) {
+				return *action.Bit, nil
+			}
+		}
+		for _, action := range *namespace.Actions {
+			if action.DisplayName != nil && action.Bit != nil && strings.EqualFold(*action.DisplayName, name) {
+				return *action.Bit, nil
+			}
+		}
+	}
+
+	var actionNames []string
+	if namespace.Actions != nil {
+		for _, action := range *namespace.Actions {
+			if action.Name != nil {
+				actionNames = append(actionNames, *action.Name)
+			}
+		}
+	}
+	slices.Sort(actionNames)
+	return 0, fmt.Errorf("permission %s does not exist in security namespace %s, valid permissions are: %s", name, *namespace.Name, strings.Join(actionNames, ", "))
+}
+
+// GetActionsBits returns the combined permission bits of the actions with the given names.
+func GetActionsBits(namespace *security.SecurityNamespaceDescription, names []string) (int, error) {
+	bits := 0
+	for _, name := range names {
+		bit, err := GetActionBit(namespace, name)
+		if err != nil {
+			return 0, err
+		}
+		bits |= bit
+	}
+	return bits, nil
+}
+
+// GetActionNames returns the names of the actions whose bits are set.
+func GetActionNames(namespace *security.SecurityNamespaceDescription, bits int) []string {
+	var names []string
+	if namespace.Actions != nil {
+		for _, action := range *namespace.Actions {
+			if action.Name != nil && action.Bit != nil && bits&*action.Bit != 0 {
+				names = append(names, *action.Name)
+			}
+		}
+	}
+	return names
+}
+
+// ApplyPermissions updates the allow and deny bits of an access control entry
+// for the given permissions. Bits of permissions that are not in the map are
+// left untouched.
+func ApplyPermissions(namespace *security.SecurityNamespaceDescription, ace *security.AccessControlEntry, permissions map[string]string) error {
+	allow, deny := *ace.Allow, *ace.Deny
+	for name, value := range permissions {
+		bit, err := GetActionBit(namespace, name)
+		if err != nil {
+			return err
+		}
+		allow &^= bit
+		deny &^= bit
+		switch value {
+		case PermissionAllow:
+			allow |= bit
+		case PermissionDeny:
+			deny |= bit
+		case PermissionNotSet:
+		default:
+			return fmt.Errorf("invalid value %s for permission %s, valid values are: %s", value, name, strings.Join(PermissionValues, ", "))
+		}
+	}
+	ace.Allow = &allow
+	ace.Deny = &deny
+	return nil
+}
+
+// ReadPermissions returns the value of each of the given permissions in an
+// access control entry.
+func ReadPermissions(namespace *security.SecurityNamespaceDescription, ace *security.AccessControlEntry, names []string) (map[string]string, error) {
+	permissions := make(map[string]string, len(names))
+	for _, name := range names {
+		bit, err := GetActionBit(namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case *ace.Deny&bit != 0:
+			permissions[name] = PermissionDeny
+		case *ace.Allow&bit != 0:
+			permissions[name] = PermissionAllow
+		default:
+			permissions[name] = PermissionNotSet
+		}
+	}
+	return permissions, nil
+}
+
+// SetPermissions sets the given permissions of a principal on a token, leaving
+// the permissions that are not in the map untouched.
+func (s *SecurityService) SetPermissions(ctx context.Context, namespaceId uuid.UUID, token string, descriptor string, permissions map[string]string) error {
+	namespace, err := s.GetNamespace(ctx, namespaceId)
+	if err != nil {
+		return err
+	}
+	ace, err := s.GetAccessControlEntry(ctx, namespaceId, token, descriptor)
+	if err != nil {
+		return err
+	}
+	if err := ApplyPermissions(namespace, ace, permissions); err != nil {
+		return err
+	}
+
+	if *ace.Allow == 0 && *ace.Deny == 0 {
+		return s.RemoveAccessControlEntry(ctx, namespaceId, token, descriptor)
+	}
+	return s.SetAccessControlEntry(ctx, namespaceId, token, ace)
+}
+
+// GetPermissions returns the value of the given permissions of a principal on a token.
+func (s *SecurityService) GetPermissions(ctx context.Context, namespaceId uuid.UUID, token string, descriptor string, names []string) (map[string]string, error) {
+	namespace, err := s.GetNamespace(ctx, namespaceId)
+	if err != nil {
+		return nil, err
+	}
+	ace, err := s.GetAccessControlEntry(ctx, namespaceId, token, descriptor)
+	if err != nil {
+		return nil, err
+	}
+	return ReadPermissions(namespace, ace, names)
+}
+
+// ResetPermissions sets the given permissions of a principal on a token back to NotSet.
+func (s *SecurityService) ResetPermissions(ctx context.Context, namespaceId uuid.UUID, token string, descriptor string, names []string) error {
+	permissions := make(map[string]string, len(names))
+	for _, name := range names {
+		permissions[name] = PermissionNotSet
+	}
+	return s.SetPermissions(ctx, namespaceId, token, descriptor, permissions)
+}
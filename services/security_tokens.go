@@ -0,0 +1,84 @@
+package services
+
+import (
+	"encoding/hex"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/google/uuid"
+)
+
+// Identifiers of the built-in security namespaces.
+var (
+	GitRepositoriesNamespaceId = uuid.MustParse("2e9eb7ed-3c0a-47d4-87c1-0ffdd275fd87")
+	ProjectNamespaceId         = uuid.MustParse("52d39943-cb85-4d7f-8fa8-c6baac873819")
+	BuildNamespaceId           = uuid.MustParse("33344d9c-fc72-4d6f-aba5-fa317101a7e9")
+	AreaNamespaceId            = uuid.MustParse("83e28ad4-2d72-4ceb-97b0-c7726d5502c3")
+	IterationNamespaceId       = uuid.MustParse("bf7bfa03-b2b7-47db-8113-fa2e002cc5b1")
+	IdentityNamespaceId        = uuid.MustParse("5a27515b-ccd7-42c9-84f1-54c998f03866")
+)
+
+// GitRepositoryToken builds the security token of a project, repository or
+// branch in the Git Repositories namespace. The repository and branch are
+// optional; a branch is only used together with a repository.
+func GitRepositoryToken(projectId string, repositoryId string, branchName string) string {
+	token := "repoV2/" + projectId
+	if repositoryId == "" {
+		return token
+	}
+	token += "/" + repositoryId
+	if branchName == "" {
+		return token
+	}
+	return token + "/refs/heads/" + EncodeBranchName(branchName)
+}
+
+// EncodeBranchName encodes every segment of a branch name as hex of its
+// UTF-16 little endian representation, the format used in git security tokens.
+func EncodeBranchName(branchName string) string {
+	branchName = strings.TrimPrefix(branchName, "refs/heads/")
+	segments := strings.Split(branchName, "/")
+	for i, segment := range segments {
+		var encoded []byte
+		for _, unit := range utf16.Encode([]rune(segment)) {
+			encoded = append(encoded, byte(unit), byte(unit>>8))
+		}
+		segments[i] = hex.EncodeToString(encoded)
+	}
+	return strings.Join(segments, "/")
+}
+
+// ProjectToken builds the security token of a project in the Project namespace.
+func ProjectToken(projectId string) string {
+	return "$PROJECT:vstfs:///Classification/TeamProject/" + projectId
+}
+
+// BuildToken builds the security token of a project, pipeline folder or build
+// definition in the Build namespace. The folder path uses backslashes like in
+// the web interface, for example \Team\Production. The definition is optional.
+func BuildToken(projectId string, folderPath string, definitionId string) string {
+	token := projectId
+	folderPath = strings.Trim(strings.ReplaceAll(folderPath, "\\", "/"), "/")
+	if folderPath != "" {
+		token += "/" + folderPath
+	}
+	if definitionId != "" {
+		token += "/" + definitionId
+	}
+	return token
+}
+
+// ClassificationNodeToken builds the security token of an area or iteration
+// path from the identifiers of the nodes from the root node down to the node.
+func ClassificationNodeToken(nodeIds []string) string {
+	segments := make([]string, 0, len(nodeIds))
+	for _, nodeId := range nodeIds {
+		segments = append(segments, "vstfs:///Classification/Node/"+nodeId)
+	}
+	return strings.Join(segments, ":")
+}
+
+// TeamToken builds the security token of a team in the Identity namespace.
+func TeamToken(projectId string, teamId string) string {
+	return projectId + "\\" + teamId
+}
@@ -0,0 +1,108 @@
+package services
+
+import "testing"
+
+func TestEncodeBranchName(t *testing.T) {
+	tests := []struct {
+		name       string
+		branchName string
+		want       string
+	}{
+		{"empty", "", ""},
+		{"simple", "main", "6d00610069006e00"},
+		{"ref prefix", "refs/heads/main", "6d00610069006e00"},
+		{"segments", "feature/x", "6600650061007400750072006500/7800"},
+		{"non ascii", "é", "e900"},
+		{"surrogate pair", "😀", "3dd800de"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EncodeBranchName(tt.branchName); got != tt.want {
+				t.Errorf("EncodeBranchName(%q) = %q, want %q", tt.branchName, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGitRepositoryToken(t *testing.T) {
+	tests := []struct {
+		name         string
+		projectId    string
+		repositoryId string
+		branchName   string
+		want         string
+	}{
+		{"project", "p", "", "", "repoV2/p"},
+		{"repository", "p", "r", "", "repoV2/p/r"},
+		{"branch", "p", "r", "main", "repoV2/p/r/refs/heads/6d00610069006e00"},
+		{"branch ref", "p", "r", "refs/heads/feature/x", "repoV2/p/r/refs/heads/6600650061007400750072006500/7800"},
+		{"branch without repository", "p", "", "main", "repoV2/p"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GitRepositoryToken(tt.projectId, tt.repositoryId, tt.branchName); got != tt.want {
+				t.Errorf("GitRepositoryToken(%q, %q, %q) = %q, want %q", tt.projectId, tt.repositoryId, tt.branchName, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildToken(t *testing.T) {
+	tests := []struct {
+		name         string
+		projectId    string
+		folderPath   string
+		definitionId string
+		want         string
+	}{
+		{"project", "p", "", "", "p"},
+		{"root folder", "p", "\\", "", "p"},
+		{"folder", "p", "\\Team\\Production", "", "p/Team/Production"},
+		{"definition", "p", "", "12", "p/12"},
+		{"definition in folder", "p", "\\Team\\", "12", "p/Team/12"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BuildToken(tt.projectId, tt.folderPath, tt.definitionId); got != tt.want {
+				t.Errorf("BuildToken(%q, %q, %q) = %q, want %q", tt.projectId, tt.folderPath, tt.definitionId, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassificationNodeToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		nodeIds []string
+		want    string
+	}{
+		{"none", nil, ""},
+		{"root", []string{"a"}, "vstfs:///Classification/Node/a"},
+		{"chain", []string{"a", "b", "c"}, "vstfs:///Classification/Node/a:vstfs:///Classification/Node/b:vstfs:///Classification/Node/c"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClassificationNodeToken(tt.nodeIds); got != tt.want {
+				t.Errorf("ClassificationNodeToken(%q) = %q, want %q", tt.nodeIds, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProjectAndTeamToken(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"project", ProjectToken("p"), "$PROJECT:vstfs:///Classification/TeamProject/p"},
+		{"team", TeamToken("p", "t"), "p\\t"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+)
+
+// TeamAdministratorBits are the Read, Write, Delete, ManageMembership and
+// CreateScope bits of the Identity namespace the web interface allows a team
+// administrator on the token of the team.
+const TeamAdministratorBits = 31
+
+func NewTeamService(client *core.ClientImpl) *TeamService {
+	return &TeamService{client: client}
+}
+
+type TeamService struct {
+	client *core.ClientImpl
+}
+
+// GetTeam returns a team of a project given its ID or name.
+func (s *TeamService) GetTeam(ctx context.Context, projectId string, team string) (*core.WebApiTeam, error) {
+	var response, error = s.client.GetTeam(ctx, core.GetTeamArgs{
+		ProjectId: &projectId,
+		TeamId:    &team,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to read team %s from azure devops: %w", team, error)
+		return &core.WebApiTeam{}, error
+	}
+
+	return response, nil
+}
+
+func (s *TeamService) CreateTeam(ctx context.Context, projectId string, name string, description string) (*core.WebApiTeam, error) {
+	tflog.Info(ctx, fmt.Sprintf("Creating team %s in project %s", name, projectId))
+	var response, error = s.client.CreateTeam(ctx, core.CreateTeamArgs{
+		ProjectId: &projectId,
+		Team: &core.WebApiTeam{
+			Name:        &name,
+			Description: &description,
+		},
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to create team %s in azure devops: %w", name, error)
+		return &core.WebApiTeam{}, error
+	}
+
+	return response, nil
+}
+
+func (s *TeamService) UpdateTeam(ctx context.Context, projectId string, teamId string, name string, description string) error {
+	tflog.Info(ctx, fmt.Sprintf("Updating team %s in project %s", teamId, projectId))
+	_, err := s.client.UpdateTeam(ctx, core.UpdateTeamArgs{
+		ProjectId: &projectId,
+		TeamId:    &teamId,
+		TeamData: &core.WebApiTeam{
+			Name:        &name,
+			Description: &description,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update team %s in azure devops: %w", teamId, err)
+	}
+	return nil
+}
+
+func (s *TeamService) DeleteTeam(ctx context.Context, projectId string, teamId string) error {
+	tflog.Info(ctx, fmt.Sprintf("Deleting team %s in project %s", teamId, projectId))
+	err := s.client.DeleteTeam(ctx, core.DeleteTeamArgs{
+		ProjectId: &projectId,
+		TeamId:    &teamId,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete team %s from azure devops: %w", teamId, err)
+	}
+	return nil
+}
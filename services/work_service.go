@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/work"
+)
+
+func NewWorkService(client *work.ClientImpl) *WorkService {
+	return &WorkService{client: client}
+}
+
+type WorkService struct {
+	client *work.ClientImpl
+}
+
+func (s *WorkService) GetTeamSettings(ctx context.Context, projectId string, teamId string) (*work.TeamSetting, error) {
+	var response, error = s.client.GetTeamSettings(ctx, work.GetTeamSettingsArgs{
+		Project: &projectId,
+		Team:    &teamId,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to read settings of team %s from azure devops: %w", teamId, error)
+		return &work.TeamSetting{}, error
+	}
+
+	return response, nil
+}
+
+// UpdateTeamSettings updates the settings of a team that are set in the patch.
+func (s *WorkService) UpdateTeamSettings(ctx context.Context, projectId string, teamId string, patch *work.TeamSettingsPatch) error {
+	tflog.Info(ctx, fmt.Sprintf("Updating settings of team %s", teamId))
+	_, err := s.client.UpdateTeamSettings(ctx, work.UpdateTeamSettingsArgs{
+		Project:           &projectId,
+		Team:              &teamId,
+		TeamSettingsPatch: patch,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update settings of team %s in azure devops: %w", teamId, err)
+	}
+	return nil
+}
+
+func (s *WorkService) GetTeamFieldValues(ctx context.Context, projectId string, teamId string) (*work.TeamFieldValues, error) {
+	var response, error = s.client.GetTeamFieldValues(ctx, work.GetTeamFieldValuesArgs{
+		Project: &projectId,
+		Team:    &teamId,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to read field values of team %s from azure devops: %w", teamId, error)
+		return &work.TeamFieldValues{}, error
+	}
+
+	return response, nil
+}
+
+// UpdateTeamFieldValues replaces the field values, usually area paths, of a team.
+func (s *WorkService) UpdateTeamFieldValues(ctx context.Context, projectId string, teamId string, patch *work.TeamFieldValuesPatch) (*work.TeamFieldValues, error) {
+	tflog.Info(ctx, fmt.Sprintf("Updating field values of team %s", teamId))
+	var response, error = s.client.UpdateTeamFieldValues(ctx, work.UpdateTeamFieldValuesArgs{
+		Project: &projectId,
+		Team:    &teamId,
+		Patch:   patch,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to update field values of team %s in azure devops: %w", teamId, error)
+		return &work.TeamFieldValues{}, error
+	}
+
+	return response, nil
+}
+
+func (s *WorkService) GetTeamIteration(ctx context.Context, projectId string, teamId string, iterationId uuid.UUID) (*work.TeamSettingsIteration, error) {
+	var response, error = s.client.GetTeamIteration(ctx, work.GetTeamIterationArgs{
+		Project: &projectId,
+		Team:    &teamId,
+		Id:      &iterationId,
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to read iteration %s of team %s from azure devops: %w", iterationId, teamId, error)
+		return &work.TeamSettingsIteration{}, error
+	}
+
+	return response, nil
+}
+
+// AddTeamIteration subscribes a team to an iteration.
+func (s *WorkService) AddTeamIteration(ctx context.Context, projectId string, teamId string, iterationId uuid.UUID) (*work.TeamSettingsIteration, error) {
+	tflog.Info(ctx, fmt.Sprintf("Adding iteration %s to team %s", iterationId, teamId))
+	var response, error = s.client.PostTeamIteration(ctx, work.PostTeamIterationArgs{
+		Project:   &projectId,
+		Team:      &teamId,
+		Iteration: &work.TeamSettingsIteration{Id: &iterationId},
+	})
+	if error != nil {
+		error = fmt.Errorf("failed to add iteration %s to team %s in azure devops: %w", iterationId, teamId, error)
+		return &work.TeamSettingsIteration{}, error
+	}
+
+	return response, nil
+}
+
+func (s *WorkService) RemoveTeamIteration(ctx context.Context, projectId string, teamId string, iterationId uuid.UUID) error {
+	tflog.Info(ctx, fmt.Sprintf("Removing iteration %s from team %s", iterationId, teamId))
+	err := s.client.DeleteTeamIteration(ctx, work.DeleteTeamIterationArgs{
+		Project: &projectId,
+		Team:    &teamId,
+		Id:      &iterationId,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove iteration %s from team %s in azure devops: %w", iterationId, teamId, err)
+	}
+	return nil
+}